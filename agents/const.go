@@ -7,36 +7,179 @@ type (
 	OS string
 	// Device represents a device type.
 	Device string
+	// Engine represents a browser rendering engine name.
+	Engine string
+	// Architecture represents a CPU architecture.
+	Architecture string
+	// Vendor represents a device manufacturer.
+	Vendor string
+	// App represents an app embedding an in-app browser.
+	App string
+	// Channel represents a browser release channel.
+	Channel string
+	// SupportStatus represents whether a browser or OS still receives updates.
+	SupportStatus string
 )
 
 const (
-	BrowserAndroid   Browser = "Android Browser"
-	BrowserChrome    Browser = "Chrome"
-	BrowserEdge      Browser = "Edge"
-	BrowserFirefox   Browser = "Firefox"
-	BrowserIE        Browser = "IE"
-	BrowserOpera     Browser = "Opera"
-	BrowserOperaMini Browser = "Opera Mini"
-	BrowserSafari    Browser = "Safari"
-	BrowserVivaldi   Browser = "Vivaldi"
-	BrowserSamsung   Browser = "Samsung Browser"
-	BrowserFalkon    Browser = "Falkon"
-	BrowserNintendo  Browser = "Nintendo Browser"
-	BrowserYandex    Browser = "Yandex Browser"
-
-	OSAndroid  OS = "Android"
-	OSChromeOS OS = "ChromeOS"
-	OSIOS      OS = "iOS"
-	OSLinux    OS = "Linux"
-	OSOpenBSD  OS = "OpenBSD"
-	OSMacOS    OS = "MacOS"
-	OSWindows  OS = "Windows"
+	BrowserAndroid      Browser = "Android Browser"
+	BrowserChrome       Browser = "Chrome"
+	BrowserEdge         Browser = "Edge"
+	BrowserEdgeLegacy   Browser = "Edge Legacy"
+	BrowserFirefox      Browser = "Firefox"
+	BrowserIE           Browser = "IE"
+	BrowserOpera        Browser = "Opera"
+	BrowserOperaMini    Browser = "Opera Mini"
+	BrowserSafari       Browser = "Safari"
+	BrowserVivaldi      Browser = "Vivaldi"
+	BrowserSamsung      Browser = "Samsung Browser"
+	BrowserFalkon       Browser = "Falkon"
+	BrowserNintendo     Browser = "Nintendo Browser"
+	BrowserYandex       Browser = "Yandex Browser"
+	BrowserSilk         Browser = "Silk"
+	BrowserUC           Browser = "UC Browser"
+	BrowserQQ           Browser = "QQ Browser"
+	BrowserHuawei       Browser = "Huawei Browser"
+	BrowserMIUI         Browser = "MIUI Browser"
+	BrowserWhale        Browser = "Whale"
+	BrowserDuckDuckGo   Browser = "DuckDuckGo"
+	BrowserCocCoc       Browser = "Coc Coc"
+	BrowserSogou        Browser = "Sogou Explorer"
+	Browser360          Browser = "360 Browser"
+	BrowserMaxthon      Browser = "Maxthon"
+	BrowserOperaGX      Browser = "Opera GX"
+	BrowserFirefoxFocus Browser = "Firefox Focus"
+	BrowserWaterfox     Browser = "Waterfox"
+	BrowserPaleMoon     Browser = "Pale Moon"
+	BrowserSeaMonkey    Browser = "SeaMonkey"
+	BrowserLibreWolf    Browser = "LibreWolf"
+	BrowserIceweasel    Browser = "Iceweasel"
+	BrowserEpiphany     Browser = "Epiphany"
+	BrowserKonqueror    Browser = "Konqueror"
+	BrowserMidori       Browser = "Midori"
+	BrowserQutebrowser  Browser = "qutebrowser"
+	BrowserLynx         Browser = "Lynx"
+	BrowserW3m          Browser = "w3m"
+	BrowserLinks        Browser = "Links"
+
+	// HTTP client libraries and command line tools.
+	BrowserJava           Browser = "Java"
+	BrowserCurl           Browser = "curl"
+	BrowserWget           Browser = "Wget"
+	BrowserPythonURLLib   Browser = "Python urllib"
+	BrowserGoHTTP         Browser = "Go HTTP Client"
+	BrowserOkHttp         Browser = "OkHttp"
+	BrowserNodeFetch      Browser = "node-fetch"
+	BrowserUndici         Browser = "undici"
+	BrowserAxios          Browser = "Axios"
+	BrowserPythonRequests Browser = "Python Requests"
+	BrowserAIOHTTP        Browser = "aiohttp"
+	BrowserHTTPX          Browser = "HTTPX"
+	BrowserApacheHTTP     Browser = "Apache HttpClient"
+	BrowserGuzzle         Browser = "Guzzle"
+	BrowserLibwwwPerl     Browser = "libwww-perl"
+	BrowserPostman        Browser = "Postman"
+	BrowserInsomnia       Browser = "Insomnia"
+	BrowserGRPCGo         Browser = "gRPC Go"
+	BrowserGRPCJava       Browser = "gRPC Java"
+	BrowserGRPCNode       Browser = "gRPC Node"
+	BrowserGRPCWeb        Browser = "gRPC Web"
+
+	OSAndroid      OS = "Android"
+	OSChromeOS     OS = "ChromeOS"
+	OSIOS          OS = "iOS"
+	OSLinux        OS = "Linux"
+	OSOpenBSD      OS = "OpenBSD"
+	OSMacOS        OS = "MacOS"
+	OSWindows      OS = "Windows"
+	OSFreeBSD      OS = "FreeBSD"
+	OSNetBSD       OS = "NetBSD"
+	OSTizen        OS = "Tizen"
+	OSWebOS        OS = "webOS"
+	OSSailfish     OS = "Sailfish OS"
+	OSKaiOS        OS = "KaiOS"
+	OSFuchsia      OS = "Fuchsia"
+	OSHarmonyOS    OS = "HarmonyOS"
+	OSTVOS         OS = "tvOS"
+	OSWatchOS      OS = "watchOS"
+	OSWindowsPhone OS = "Windows Phone"
 
 	DeviceDesktop Device = "Desktop"
 	DeviceMobile  Device = "Mobile"
 	DeviceTablet  Device = "Tablet"
 	DeviceTV      Device = "TV"
 	DeviceBot     Device = "Bot"
+	// DeviceLibrary is used for HTTP client libraries and command line tools.
+	DeviceLibrary  Device = "Library"
+	DeviceConsole  Device = "Console"
+	DeviceWearable Device = "Wearable"
+	// DeviceXR is used for virtual and mixed reality headsets.
+	DeviceXR      Device = "XR"
+	DeviceCar     Device = "Car"
+	DeviceEReader Device = "E-Reader"
+	// DeviceSmartSpeaker also includes smart displays such as the Google Nest Hub.
+	DeviceSmartSpeaker Device = "Smart Speaker"
+
+	EngineBlink    Engine = "Blink"
+	EngineWebKit   Engine = "WebKit"
+	EngineGecko    Engine = "Gecko"
+	EngineTrident  Engine = "Trident"
+	EngineEdgeHTML Engine = "EdgeHTML"
+	EnginePresto   Engine = "Presto"
+
+	ArchX86     Architecture = "x86"
+	ArchX86_64  Architecture = "x86_64"
+	ArchARM     Architecture = "arm"
+	ArchARM64   Architecture = "arm64"
+	ArchUnknown Architecture = "unknown"
+
+	VendorApple    Vendor = "Apple"
+	VendorSamsung  Vendor = "Samsung"
+	VendorGoogle   Vendor = "Google"
+	VendorXiaomi   Vendor = "Xiaomi"
+	VendorRealme   Vendor = "Realme"
+	VendorOppo     Vendor = "OPPO"
+	VendorVivo     Vendor = "vivo"
+	VendorOnePlus  Vendor = "OnePlus"
+	VendorMotorola Vendor = "Motorola"
+	VendorHuawei   Vendor = "Huawei"
+	VendorHonor    Vendor = "Honor"
+	VendorLG       Vendor = "LG"
+	VendorSony     Vendor = "Sony"
+	VendorNokia    Vendor = "Nokia"
+	VendorHTC      Vendor = "HTC"
+	VendorLenovo   Vendor = "Lenovo"
+	VendorAsus     Vendor = "Asus"
+	VendorZTE      Vendor = "ZTE"
+	VendorAlcatel  Vendor = "Alcatel"
+	VendorMicromax Vendor = "Micromax"
+	VendorAmazon   Vendor = "Amazon"
+	VendorInfinix  Vendor = "Infinix"
+	VendorTecno    Vendor = "Tecno"
+
+	AppFacebook  App = "Facebook"
+	AppInstagram App = "Instagram"
+	AppTikTok    App = "TikTok"
+	AppLinkedIn  App = "LinkedIn"
+	AppSnapchat  App = "Snapchat"
+	AppWeChat    App = "WeChat"
+	AppLine      App = "Line"
+)
+
+const (
+	ChannelStable  Channel = "Stable"
+	ChannelBeta    Channel = "Beta"
+	ChannelDev     Channel = "Dev"
+	ChannelCanary  Channel = "Canary"
+	ChannelNightly Channel = "Nightly"
+	ChannelESR     Channel = "ESR"
+	ChannelPreview Channel = "Technology Preview"
+)
+
+const (
+	SupportCurrent   SupportStatus = "Current"
+	SupportOutdated  SupportStatus = "Outdated"
+	SupportEndOfLife SupportStatus = "End of Life"
 )
 
 func (b Browser) String() string {
@@ -50,3 +193,27 @@ func (o OS) String() string {
 func (d Device) String() string {
 	return string(d)
 }
+
+func (e Engine) String() string {
+	return string(e)
+}
+
+func (a Architecture) String() string {
+	return string(a)
+}
+
+func (v Vendor) String() string {
+	return string(v)
+}
+
+func (a App) String() string {
+	return string(a)
+}
+
+func (c Channel) String() string {
+	return string(c)
+}
+
+func (s SupportStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,175 @@
+package agents
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// scan stores a string or []byte database value in dst, so the agents types
+// can be read from text columns. NULL is stored as an empty string.
+func scan[T ~string](dst *T, src any) error {
+	switch v := src.(type) {
+	case nil:
+		*dst = ""
+	case string:
+		*dst = T(v)
+	case []byte:
+		*dst = T(v)
+	default:
+		return fmt.Errorf("agents: cannot scan %T into %T", src, *dst)
+	}
+	return nil
+}
+
+func (b Browser) MarshalText() ([]byte, error) {
+	return []byte(b), nil
+}
+
+func (b *Browser) UnmarshalText(text []byte) error {
+	*b = Browser(text)
+	return nil
+}
+
+func (b Browser) Value() (driver.Value, error) {
+	return string(b), nil
+}
+
+func (b *Browser) Scan(src any) error {
+	return scan(b, src)
+}
+
+func (o OS) MarshalText() ([]byte, error) {
+	return []byte(o), nil
+}
+
+func (o *OS) UnmarshalText(text []byte) error {
+	*o = OS(text)
+	return nil
+}
+
+func (o OS) Value() (driver.Value, error) {
+	return string(o), nil
+}
+
+func (o *OS) Scan(src any) error {
+	return scan(o, src)
+}
+
+func (d Device) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+func (d *Device) UnmarshalText(text []byte) error {
+	*d = Device(text)
+	return nil
+}
+
+func (d Device) Value() (driver.Value, error) {
+	return string(d), nil
+}
+
+func (d *Device) Scan(src any) error {
+	return scan(d, src)
+}
+
+func (e Engine) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *Engine) UnmarshalText(text []byte) error {
+	*e = Engine(text)
+	return nil
+}
+
+func (e Engine) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
+func (e *Engine) Scan(src any) error {
+	return scan(e, src)
+}
+
+func (a Architecture) MarshalText() ([]byte, error) {
+	return []byte(a), nil
+}
+
+func (a *Architecture) UnmarshalText(text []byte) error {
+	*a = Architecture(text)
+	return nil
+}
+
+func (a Architecture) Value() (driver.Value, error) {
+	return string(a), nil
+}
+
+func (a *Architecture) Scan(src any) error {
+	return scan(a, src)
+}
+
+func (v Vendor) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+func (v *Vendor) UnmarshalText(text []byte) error {
+	*v = Vendor(text)
+	return nil
+}
+
+func (v Vendor) Value() (driver.Value, error) {
+	return string(v), nil
+}
+
+func (v *Vendor) Scan(src any) error {
+	return scan(v, src)
+}
+
+func (a App) MarshalText() ([]byte, error) {
+	return []byte(a), nil
+}
+
+func (a *App) UnmarshalText(text []byte) error {
+	*a = App(text)
+	return nil
+}
+
+func (a App) Value() (driver.Value, error) {
+	return string(a), nil
+}
+
+func (a *App) Scan(src any) error {
+	return scan(a, src)
+}
+
+func (c Channel) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+func (c *Channel) UnmarshalText(text []byte) error {
+	*c = Channel(text)
+	return nil
+}
+
+func (c Channel) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+func (c *Channel) Scan(src any) error {
+	return scan(c, src)
+}
+
+func (s SupportStatus) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+func (s *SupportStatus) UnmarshalText(text []byte) error {
+	*s = SupportStatus(text)
+	return nil
+}
+
+func (s SupportStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+func (s *SupportStatus) Scan(src any) error {
+	return scan(s, src)
+}
@@ -0,0 +1,146 @@
+package useragent
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// batchChunkSize is the number of user agents a goroutine parses at a time.
+// Chunks are claimed as goroutines finish, so slower user agents do not leave
+// other goroutines idle.
+const batchChunkSize = 256
+
+// streamBatchSize is the maximum number of user agents ParseStream reads from
+// its input before parsing them.
+const streamBatchSize = 1024
+
+// ParseBatch parses each user agent in in and stores it at the same index of
+// out, splitting the work across GOMAXPROCS goroutines. This is safe as the
+// trie is read-only after construction. It panics if out is shorter than in.
+func (p *Parser) ParseBatch(in []string, out []UserAgent) {
+	if len(out) < len(in) {
+		panic("useragent: ParseBatch output is shorter than its input")
+	}
+
+	workers := min(runtime.GOMAXPROCS(0), (len(in)+batchChunkSize-1)/batchChunkSize)
+	if workers <= 1 {
+		for i, ua := range in {
+			out[i] = p.Parse(ua)
+		}
+		return
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				end := int(next.Add(batchChunkSize))
+				start := end - batchChunkSize
+				if start >= len(in) {
+					return
+				}
+				for i := start; i < min(end, len(in)); i++ {
+					out[i] = p.Parse(in[i])
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// ParseStream parses the user agents received from in across GOMAXPROCS
+// goroutines, and sends their results in the same order they were received.
+// The returned channel is closed once in is closed and all results are sent,
+// or when ctx is done.
+func (p *Parser) ParseStream(ctx context.Context, in <-chan string) <-chan Result {
+	out := make(chan Result, streamBatchSize)
+	workers := runtime.GOMAXPROCS(0)
+
+	// pending holds the batches being parsed in the order they were read, which
+	// also limits the number of batches parsed at once.
+	pending := make(chan chan []Result, workers)
+
+	go func() {
+		defer close(pending)
+		for {
+			batch, ok := readBatch(ctx, in)
+			if len(batch) > 0 {
+				done := make(chan []Result, 1)
+				select {
+				case pending <- done:
+				case <-ctx.Done():
+					return
+				}
+
+				go func() {
+					results := make([]Result, len(batch))
+					for i, ua := range batch {
+						results[i] = p.Parse(ua).Result()
+					}
+					done <- results
+				}()
+			}
+			if !ok {
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer close(out)
+		for done := range pending {
+			var results []Result
+			select {
+			case results = <-done:
+			case <-ctx.Done():
+				return
+			}
+
+			for _, r := range results {
+				select {
+				case out <- r:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// readBatch waits for the next user agent from in, then reads any others that
+// are ready up to streamBatchSize, so slow producers are not delayed. It
+// returns false once in is closed or ctx is done.
+func readBatch(ctx context.Context, in <-chan string) ([]string, bool) {
+	var batch []string
+
+	select {
+	case ua, ok := <-in:
+		if !ok {
+			return nil, false
+		}
+		batch = append(make([]string, 0, streamBatchSize), ua)
+	case <-ctx.Done():
+		return nil, false
+	}
+
+	for len(batch) < streamBatchSize {
+		select {
+		case ua, ok := <-in:
+			if !ok {
+				return batch, false
+			}
+			batch = append(batch, ua)
+		default:
+			return batch, true
+		}
+	}
+
+	return batch, true
+}
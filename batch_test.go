@@ -0,0 +1,73 @@
+package useragent_test
+
+import (
+	"context"
+	"testing"
+
+	ua "github.com/medama-io/go-useragent"
+	"github.com/medama-io/go-useragent/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchInput repeats the test cases so the batch is split across goroutines.
+func batchInput() []string {
+	var in []string
+	for len(in) < 5000 {
+		in = append(in, testdata.TestCases...)
+	}
+	return in
+}
+
+func TestParseBatch(t *testing.T) {
+	parser := ua.NewParser()
+	in := batchInput()
+	out := make([]ua.UserAgent, len(in))
+
+	parser.ParseBatch(in, out)
+	for i, k := range in {
+		require.Equal(t, parser.Parse(k), out[i], "Test Case: %s", k)
+	}
+
+	assert.Panics(t, func() { parser.ParseBatch(in, out[:1]) })
+}
+
+func TestParseStream(t *testing.T) {
+	parser := ua.NewParser()
+	in := batchInput()
+
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		for _, k := range in {
+			ch <- k
+		}
+	}()
+
+	var results []ua.Result
+	for r := range parser.ParseStream(context.Background(), ch) {
+		results = append(results, r)
+	}
+
+	require.Len(t, results, len(in))
+	for i, k := range in {
+		require.Equal(t, parser.Parse(k).Result(), results[i], "Test Case: %s", k)
+	}
+}
+
+func TestParseStreamCancel(t *testing.T) {
+	parser := ua.NewParser()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The input is never closed, so the output is only closed by the context.
+	ch := make(chan string, 1)
+	ch <- testdata.TestCases[0]
+	out := parser.ParseStream(ctx, ch)
+
+	r := <-out
+	assert.Equal(t, parser.Parse(testdata.TestCases[0]).Result(), r)
+
+	cancel()
+	for range out {
+	}
+}
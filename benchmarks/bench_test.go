@@ -1,6 +1,7 @@
 package useragent_test
 
 import (
+	"context"
 	"testing"
 
 	medama "github.com/medama-io/go-useragent"
@@ -68,6 +69,46 @@ func BenchmarkMileusnaParserGetSingle(b *testing.B) {
 	}
 }
 
+// Batch benchmarks parse the test cases repeated to 100k user agents. Run them
+// with -cpu 1,2,4,8 to compare how they scale with GOMAXPROCS.
+func batchCases() []string {
+	cases := make([]string, 0, 100_000)
+	for len(cases) < cap(cases) {
+		cases = append(cases, testdata.TestCases[:min(len(testdata.TestCases), cap(cases)-len(cases))]...)
+	}
+	return cases
+}
+
+func BenchmarkMedamaParserBatch(b *testing.B) {
+	parser := medama.NewParser()
+	cases := batchCases()
+	out := make([]medama.UserAgent, len(cases))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		parser.ParseBatch(cases, out)
+	}
+}
+
+func BenchmarkMedamaParserStream(b *testing.B) {
+	parser := medama.NewParser()
+	cases := batchCases()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		in := make(chan string, 1024)
+		go func() {
+			for _, k := range cases {
+				in <- k
+			}
+			close(in)
+		}()
+
+		for range parser.ParseStream(context.Background(), in) {
+		}
+	}
+}
+
 // Extra benchmarks for trie implementations
 func BenchmarkMedamaParserPutAll(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,228 @@
+// Package botverify confirms that a user agent claiming to be a crawler is
+// operated by who it claims to be.
+//
+// Anyone can send "Googlebot" in a user agent, so the result of IsBot alone
+// can be spoofed. Major search engines instead recommend verifying crawlers
+// with a reverse DNS lookup of the remote IP, checking the hostname belongs to
+// the operator, and a forward lookup confirming the hostname resolves back to
+// the same IP. Some operators also publish the IP ranges their crawlers use.
+package botverify
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/medama-io/go-useragent"
+)
+
+// Resolver performs the DNS lookups needed to verify a crawler. It is
+// satisfied by *net.Resolver, and can be replaced with a fake resolver to
+// verify crawlers offline in tests.
+type Resolver interface {
+	// LookupAddr performs a reverse lookup for the given address, returning
+	// a list of names mapping to that address.
+	LookupAddr(ctx context.Context, addr string) ([]string, error)
+	// LookupHost looks up the given host, returning a list of its addresses.
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// Crawler describes how to verify the crawlers of a single operator.
+type Crawler struct {
+	// Name is the name of the crawler operator, e.g. "Google".
+	Name string
+	// Tokens are the user agent tokens of the operator's crawlers, e.g.
+	// "Googlebot". A user agent is only verified against the operator it
+	// claims to be. Tokens are matched case-insensitively.
+	Tokens []string
+	// Domains are the domains the reverse DNS hostname of a crawler must
+	// belong to, e.g. "googlebot.com". Subdomains are also accepted.
+	Domains []string
+	// Ranges are the published IP ranges of the crawler. Addresses within
+	// these ranges are verified without any DNS lookups.
+	Ranges []netip.Prefix
+}
+
+// DefaultCrawlers is the list of crawler operators that document reverse DNS
+// verification for their crawlers.
+//
+// Operators that only publish IP ranges can be verified by appending their
+// ranges, for example using ParseRanges on their published JSON file.
+var DefaultCrawlers = []Crawler{
+	// Only the App Engine subdomain of googleusercontent.com is used by Google's
+	// fetchers, as every Compute Engine VM has a reverse record under it.
+	{
+		Name:    "Google",
+		Tokens:  []string{"Googlebot", "AdsBot-Google", "Mediapartners-Google", "Google-InspectionTool", "GoogleOther", "Storebot-Google", "APIs-Google", "FeedFetcher-Google"},
+		Domains: []string{"googlebot.com", "google.com", "gae.googleusercontent.com"},
+	},
+	{Name: "Bing", Tokens: []string{"bingbot", "BingPreview", "msnbot", "adidxbot"}, Domains: []string{"search.msn.com"}},
+	{Name: "Yahoo", Tokens: []string{"Slurp"}, Domains: []string{"crawl.yahoo.net"}},
+	{Name: "Yandex", Tokens: []string{"Yandex"}, Domains: []string{"yandex.ru", "yandex.net", "yandex.com"}},
+	{Name: "Baidu", Tokens: []string{"Baiduspider"}, Domains: []string{"baidu.com", "baidu.jp"}},
+	{Name: "Apple", Tokens: []string{"Applebot"}, Domains: []string{"applebot.apple.com"}},
+	{Name: "Amazon", Tokens: []string{"Amazonbot"}, Domains: []string{"crawl.amazonbot.amazon"}},
+	{Name: "Sogou", Tokens: []string{"Sogou"}, Domains: []string{"crawl.sogou.com"}},
+	{Name: "Petal", Tokens: []string{"PetalBot"}, Domains: []string{"petalsearch.com"}},
+	{Name: "Seznam", Tokens: []string{"SeznamBot"}, Domains: []string{"seznam.cz"}},
+	{Name: "Naver", Tokens: []string{"Yeti"}, Domains: []string{"naver.com"}},
+}
+
+// Result is the outcome of verifying a crawler.
+type Result struct {
+	// Verified is true if the remote IP belongs to a known crawler operator.
+	Verified bool
+	// Crawler is the name of the verified crawler operator.
+	Crawler string
+	// Hostname is the reverse DNS hostname that verified the crawler. It is
+	// empty if the crawler was verified using its published IP ranges.
+	Hostname string
+}
+
+// Verifier verifies crawlers using DNS lookups and published IP ranges.
+// It is safe for concurrent use.
+type Verifier struct {
+	resolver Resolver
+	cache    Cache
+	crawlers []Crawler
+}
+
+// New creates a new Verifier for the DefaultCrawlers. If resolver is nil,
+// net.DefaultResolver is used. If cache is nil, results are not cached.
+func New(resolver Resolver, cache Cache) *Verifier {
+	return NewWithCrawlers(resolver, cache, DefaultCrawlers)
+}
+
+// NewWithCrawlers creates a new Verifier for the given crawler operators.
+func NewWithCrawlers(resolver Resolver, cache Cache, crawlers []Crawler) *Verifier {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	return &Verifier{resolver: resolver, cache: cache, crawlers: crawlers}
+}
+
+// Verify checks whether a user agent claiming to be a crawler is sent from an
+// IP address belonging to the operator it claims to be, e.g. a "Googlebot"
+// user agent must be sent from Google.
+//
+// User agents without the token of a known crawler are never verified. DNS
+// lookups that fail because the record does not exist are treated as an
+// unverified crawler, while any other lookup failure is returned as an error
+// and not cached.
+func (v *Verifier) Verify(ctx context.Context, userAgent string, ip netip.Addr) (Result, error) {
+	crawler, ok := v.claim(userAgent)
+	if !ok || !ip.IsValid() {
+		return Result{}, nil
+	}
+
+	key := CacheKey{Crawler: crawler.Name, IP: ip.Unmap()}
+
+	if v.cache != nil {
+		if result, ok := v.cache.Get(key); ok {
+			return result, nil
+		}
+	}
+
+	result, err := verify(ctx, v.resolver, crawler, key.IP)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if v.cache != nil {
+		v.cache.Set(key, result)
+	}
+
+	return result, nil
+}
+
+// VerifyUserAgent is like Verify, but takes a user agent that was already
+// parsed, so callers do not need to keep the user agent string around. The
+// crawler tokens are matched against its raw user agent, so crawlers the parser
+// does not report as bots are still verified. A UserAgent restored from a Result no
+// longer has its raw user agent and is never verified.
+func (v *Verifier) VerifyUserAgent(ctx context.Context, agent useragent.UserAgent, ip netip.Addr) (Result, error) {
+	return v.Verify(ctx, agent.Raw(), ip)
+}
+
+// claim returns the crawler operator the user agent claims to be.
+func (v *Verifier) claim(userAgent string) (Crawler, bool) {
+	userAgent = strings.ToLower(userAgent)
+	for _, crawler := range v.crawlers {
+		for _, token := range crawler.Tokens {
+			if strings.Contains(userAgent, strings.ToLower(token)) {
+				return crawler, true
+			}
+		}
+	}
+
+	return Crawler{}, false
+}
+
+// verify checks whether the IP address belongs to the crawler operator.
+func verify(ctx context.Context, resolver Resolver, crawler Crawler, ip netip.Addr) (Result, error) {
+	// Published IP ranges do not require any lookups.
+	for _, prefix := range crawler.Ranges {
+		if prefix.Contains(ip) {
+			return Result{Verified: true, Crawler: crawler.Name}, nil
+		}
+	}
+
+	if len(crawler.Domains) == 0 {
+		return Result{}, nil
+	}
+
+	names, err := resolver.LookupAddr(ctx, ip.String())
+	if err != nil {
+		if isNotFound(err) {
+			return Result{}, nil
+		}
+		return Result{}, err
+	}
+
+	for _, name := range names {
+		hostname := strings.TrimSuffix(name, ".")
+		if !matchDomain(hostname, crawler.Domains) {
+			continue
+		}
+
+		// The reverse DNS record is controlled by whoever owns the IP, so we
+		// need to confirm the hostname resolves back to the same address.
+		addrs, err := resolver.LookupHost(ctx, hostname)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return Result{}, err
+		}
+
+		for _, addr := range addrs {
+			forward, err := netip.ParseAddr(addr)
+			if err == nil && forward.Unmap() == ip {
+				return Result{Verified: true, Crawler: crawler.Name, Hostname: hostname}, nil
+			}
+		}
+	}
+
+	return Result{}, nil
+}
+
+// matchDomain returns true if the hostname is a subdomain of one of the
+// domains.
+func matchDomain(hostname string, domains []string) bool {
+	hostname = strings.ToLower(hostname)
+	for _, domain := range domains {
+		if strings.HasSuffix(hostname, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
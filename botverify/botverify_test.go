@@ -0,0 +1,187 @@
+package botverify_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/medama-io/go-useragent"
+	"github.com/medama-io/go-useragent/botverify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	googlebot = "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"
+	bingbot   = "Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)"
+	browser   = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"
+)
+
+// fakeResolver resolves lookups from static maps and counts the lookups made.
+type fakeResolver struct {
+	reverse map[string][]string
+	forward map[string][]string
+	lookups int
+}
+
+func (r *fakeResolver) LookupAddr(_ context.Context, addr string) ([]string, error) {
+	r.lookups++
+	names, ok := r.reverse[addr]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: addr, IsNotFound: true}
+	}
+	return names, nil
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	r.lookups++
+	addrs, ok := r.forward[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func newResolver() *fakeResolver {
+	return &fakeResolver{
+		reverse: map[string][]string{
+			"66.249.66.1":  {"crawl-66-249-66-1.googlebot.com."},
+			"157.55.39.1":  {"msnbot-157-55-39-1.search.msn.com."},
+			"203.0.113.10": {"crawl-203-0-113-10.googlebot.com."},
+			"198.51.100.1": {"host-198-51-100-1.example.com."},
+			"34.1.2.3":     {"3.2.1.34.bc.googleusercontent.com."},
+		},
+		forward: map[string][]string{
+			"crawl-66-249-66-1.googlebot.com":   {"66.249.66.1"},
+			"msnbot-157-55-39-1.search.msn.com": {"157.55.39.1"},
+			"3.2.1.34.bc.googleusercontent.com": {"34.1.2.3"},
+			// Spoofed reverse record that does not resolve back to the IP.
+			"crawl-203-0-113-10.googlebot.com": {"66.249.66.2"},
+		},
+	}
+}
+
+func TestVerify(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Agent    string
+		IP       string
+		Expected botverify.Result
+	}{
+		{"Google", googlebot, "66.249.66.1", botverify.Result{Verified: true, Crawler: "Google", Hostname: "crawl-66-249-66-1.googlebot.com"}},
+		{"Bing", bingbot, "157.55.39.1", botverify.Result{Verified: true, Crawler: "Bing", Hostname: "msnbot-157-55-39-1.search.msn.com"}},
+		{"Googlebot from Bing", googlebot, "157.55.39.1", botverify.Result{}},
+		{"Bingbot from Google", bingbot, "66.249.66.1", botverify.Result{}},
+		{"IPv4-mapped IPv6", googlebot, "::ffff:66.249.66.1", botverify.Result{Verified: true, Crawler: "Google", Hostname: "crawl-66-249-66-1.googlebot.com"}},
+		{"Forward lookup mismatch", googlebot, "203.0.113.10", botverify.Result{}},
+		{"Unknown domain", googlebot, "198.51.100.1", botverify.Result{}},
+		{"Google Cloud VM", googlebot, "34.1.2.3", botverify.Result{}},
+		{"No reverse record", googlebot, "192.0.2.1", botverify.Result{}},
+		{"Not a bot", browser, "66.249.66.1", botverify.Result{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			verifier := botverify.New(newResolver(), nil)
+			result, err := verifier.Verify(context.Background(), c.Agent, netip.MustParseAddr(c.IP))
+			require.NoError(t, err)
+			assert.Equal(t, c.Expected, result)
+		})
+	}
+}
+
+func TestVerifyUserAgent(t *testing.T) {
+	verifier := botverify.New(newResolver(), nil)
+	parser := useragent.NewParser()
+	ip := netip.MustParseAddr("66.249.66.1")
+
+	agent := parser.Parse(googlebot)
+	require.True(t, agent.IsBot())
+
+	result, err := verifier.VerifyUserAgent(context.Background(), agent, ip)
+	require.NoError(t, err)
+	assert.Equal(t, botverify.Result{Verified: true, Crawler: "Google", Hostname: "crawl-66-249-66-1.googlebot.com"}, result)
+
+	result, err = verifier.VerifyUserAgent(context.Background(), parser.Parse(browser), ip)
+	require.NoError(t, err)
+	assert.False(t, result.Verified)
+
+	// A restored result no longer has the raw user agent to match.
+	var restored useragent.UserAgent
+	data, err := json.Marshal(agent)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &restored))
+
+	result, err = verifier.VerifyUserAgent(context.Background(), restored, ip)
+	require.NoError(t, err)
+	assert.False(t, result.Verified)
+}
+
+func TestVerifyRanges(t *testing.T) {
+	ranges, err := botverify.ParseRanges(strings.NewReader(`{
+		"creationTime": "2024-01-01T00:00:00.000000",
+		"prefixes": [{"ipv4Prefix": "20.15.240.64/28"}, {"ipv6Prefix": "2001:db8::/32"}]
+	}`))
+	require.NoError(t, err)
+	require.Len(t, ranges, 2)
+
+	resolver := newResolver()
+	crawlers := []botverify.Crawler{{Name: "OpenAI", Tokens: []string{"GPTBot"}, Ranges: ranges}}
+	verifier := botverify.NewWithCrawlers(resolver, nil, crawlers)
+	bot := "Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko); compatible; GPTBot/1.2; +https://openai.com/gptbot"
+
+	result, err := verifier.Verify(context.Background(), bot, netip.MustParseAddr("20.15.240.70"))
+	require.NoError(t, err)
+	assert.Equal(t, botverify.Result{Verified: true, Crawler: "OpenAI"}, result)
+
+	result, err = verifier.Verify(context.Background(), bot, netip.MustParseAddr("2001:db8::1"))
+	require.NoError(t, err)
+	assert.True(t, result.Verified)
+	assert.Equal(t, 0, resolver.lookups, "Published ranges should not require DNS lookups")
+}
+
+func TestVerifyCache(t *testing.T) {
+	resolver := newResolver()
+	verifier := botverify.New(resolver, botverify.NewMemoryCache(time.Hour))
+	ip := netip.MustParseAddr("157.55.39.1")
+
+	for i := 0; i < 3; i++ {
+		result, err := verifier.Verify(context.Background(), bingbot, ip)
+		require.NoError(t, err)
+		assert.True(t, result.Verified)
+	}
+
+	assert.Equal(t, 2, resolver.lookups, "Only the first verification should perform lookups")
+
+	// The cached result of one operator is not used for another.
+	result, err := verifier.Verify(context.Background(), googlebot, ip)
+	require.NoError(t, err)
+	assert.False(t, result.Verified)
+}
+
+type failingResolver struct{}
+
+func (failingResolver) LookupAddr(context.Context, string) ([]string, error) {
+	return nil, errors.New("network unreachable")
+}
+
+func (failingResolver) LookupHost(context.Context, string) ([]string, error) {
+	return nil, errors.New("network unreachable")
+}
+
+func TestVerifyError(t *testing.T) {
+	cache := botverify.NewMemoryCache(time.Hour)
+	verifier := botverify.New(failingResolver{}, cache)
+	ip := netip.MustParseAddr("66.249.66.1")
+
+	_, err := verifier.Verify(context.Background(), googlebot, ip)
+	assert.Error(t, err)
+
+	_, ok := cache.Get(botverify.CacheKey{Crawler: "Google", IP: ip})
+	assert.False(t, ok, "Lookup failures should not be cached")
+}
@@ -0,0 +1,84 @@
+package botverify
+
+import (
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// CacheKey identifies a verification result. The claimed crawler operator is
+// part of the key, as an IP address is only verified for a single operator.
+type CacheKey struct {
+	Crawler string
+	IP      netip.Addr
+}
+
+// Cache stores verification results by crawler operator and IP address, so
+// repeated requests from the same crawler do not require new DNS lookups.
+type Cache interface {
+	Get(key CacheKey) (Result, bool)
+	Set(key CacheKey, result Result)
+}
+
+// maxMemoryCacheEntries bounds the size of the memory cache, as the remote
+// IPs are controlled by the client.
+const maxMemoryCacheEntries = 100_000
+
+type cacheEntry struct {
+	result  Result
+	expires time.Time
+}
+
+// MemoryCache is an in-memory Cache where entries expire after a fixed TTL.
+// It is safe for concurrent use.
+type MemoryCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[CacheKey]cacheEntry
+	// now is used to get the current time, and can be replaced in tests.
+	now func() time.Time
+}
+
+// NewMemoryCache creates a new MemoryCache with the given TTL.
+func NewMemoryCache(ttl time.Duration) *MemoryCache {
+	return &MemoryCache{
+		ttl:     ttl,
+		entries: make(map[CacheKey]cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached result for a key if it has not expired.
+func (c *MemoryCache) Get(key CacheKey) (Result, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok || c.now().After(entry.expires) {
+		return Result{}, false
+	}
+
+	return entry.result, true
+}
+
+// Set caches the result for a key.
+func (c *MemoryCache) Set(key CacheKey, result Result) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.entries) >= maxMemoryCacheEntries {
+		// Remove expired entries first, and only drop everything if the
+		// cache is still full.
+		for k, v := range c.entries {
+			if now.After(v.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxMemoryCacheEntries {
+			c.entries = make(map[CacheKey]cacheEntry)
+		}
+	}
+
+	c.entries[key] = cacheEntry{result: result, expires: now.Add(c.ttl)}
+}
@@ -0,0 +1,44 @@
+package botverify
+
+import (
+	"encoding/json"
+	"io"
+	"net/netip"
+)
+
+// rangesFile is the JSON format used by Google, Bing and OpenAI to publish
+// the IP ranges of their crawlers, e.g. googlebot.json or gptbot.json.
+type rangesFile struct {
+	Prefixes []struct {
+		IPv4Prefix string `json:"ipv4Prefix"`
+		IPv6Prefix string `json:"ipv6Prefix"`
+	} `json:"prefixes"`
+}
+
+// ParseRanges reads a published list of crawler IP ranges in the JSON format
+// used by Google, Bing and OpenAI, so they can be added to Crawler.Ranges.
+func ParseRanges(r io.Reader) ([]netip.Prefix, error) {
+	var file rangesFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, err
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(file.Prefixes))
+	for _, p := range file.Prefixes {
+		s := p.IPv4Prefix
+		if s == "" {
+			s = p.IPv6Prefix
+		}
+		if s == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
@@ -0,0 +1,133 @@
+package useragent
+
+import (
+	"time"
+
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/medama-io/go-useragent/internal"
+)
+
+// WithStableVersions returns a parser that detects pre-release channels using
+// the given major versions of the current stable releases, e.g. Chrome 155,
+// instead of the embedded release table. The table is only as current as this
+// library, so long running services can keep it up to date without upgrading.
+// The returned parser shares the trie with p.
+func (p *Parser) WithStableVersions(versions map[agents.Browser]int) *Parser {
+	stable := make(map[agents.Browser]int, len(p.stableVersions)+len(versions))
+	for browser, major := range p.stableVersions {
+		stable[browser] = major
+	}
+	for browser, major := range versions {
+		stable[browser] = major
+	}
+
+	return &Parser{Trie: p.Trie, stableVersions: stable}
+}
+
+// stableVersion returns the major version of the newest release of the browser
+// at the given time, and false if the browser is not in the release table.
+func stableVersion(browser agents.Browser, now time.Time) (int, bool) {
+	releasesOnce.Do(loadReleases)
+	list := releases[releaseKey{kind: internal.ReleaseBrowser, name: string(browser)}]
+	for i := len(list) - 1; i >= 0; i-- {
+		if !now.Before(list[i].released) {
+			return list[i].version.Major, true
+		}
+	}
+
+	return 0, false
+}
+
+// isExtendedSupport returns true if the release table marks the major version
+// of the browser as an extended support release, i.e. it is supported
+// alongside newer releases.
+func isExtendedSupport(browser agents.Browser, major int) bool {
+	releasesOnce.Do(loadReleases)
+	for _, r := range releases[releaseKey{kind: internal.ReleaseBrowser, name: string(browser)}] {
+		if r.version.Major == major {
+			return r.longTerm || !r.eol.IsZero()
+		}
+	}
+	return false
+}
+
+// BrowserChannelAt returns the release channel of the browser at the given
+// time, e.g. Beta or Nightly. Channels are taken from version suffixes when
+// available, otherwise browsers reporting a version ahead of the newest release
+// in the embedded release table, or the stable versions of the parser, are
+// assumed to be pre-release builds. If the user agent is a bot or library, the
+// browser has no known release channels or no browser version is found, it
+// returns an empty string.
+func (ua UserAgent) BrowserChannelAt(now time.Time) agents.Channel {
+	if ua.IsBot() || ua.IsLibrary() {
+		return ""
+	}
+
+	if ua.channel != "" {
+		return ua.channel
+	}
+
+	browser := ua.Browser()
+	if ua.versionIndex == 0 || !hasChannels(browser) {
+		return ""
+	}
+
+	stable, ok := stableVersion(browser, now)
+	if !ok {
+		return agents.ChannelStable
+	}
+
+	return ua.releaseChannel(stable)
+}
+
+// hasChannels returns true if the browser has release channels whose
+// pre-release builds can be detected from their version.
+func hasChannels(browser agents.Browser) bool {
+	switch browser {
+	case agents.BrowserChrome, agents.BrowserEdge, agents.BrowserFirefox, agents.BrowserSafari:
+		return true
+	}
+	return false
+}
+
+// releaseChannel returns the release channel of the browser given the major
+// version of its current stable release.
+func (ua UserAgent) releaseChannel(stable int) agents.Channel {
+	major := ua.Version().Major
+
+	switch browser := ua.Browser(); browser {
+	case agents.BrowserChrome, agents.BrowserEdge:
+		// Beta is one version ahead of stable and Dev is two, while Canary
+		// builds nightly and can be a version ahead of Dev.
+		switch {
+		case major == stable+1:
+			return agents.ChannelBeta
+		case major == stable+2:
+			return agents.ChannelDev
+		case major > stable+2:
+			return agents.ChannelCanary
+		}
+
+	case agents.BrowserFirefox:
+		// Beta and Nightly builds are identified by their version suffix, while
+		// Extended Support Releases report the same user agent as the release
+		// channel.
+		if major < stable && isExtendedSupport(browser, major) {
+			return agents.ChannelESR
+		}
+
+	case agents.BrowserSafari:
+		// Safari Technology Preview reports the upcoming Safari version.
+		if major > stable {
+			return agents.ChannelPreview
+		}
+	}
+
+	return agents.ChannelStable
+}
+
+// BrowserChannel returns the release channel of the browser today. See
+// BrowserChannelAt for details.
+func (ua UserAgent) BrowserChannel() agents.Channel {
+	return ua.BrowserChannelAt(time.Now())
+}
@@ -0,0 +1,70 @@
+package useragent_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	ua "github.com/medama-io/go-useragent"
+
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrowserChannel(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent string
+		Channel   agents.Channel
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/153.0.0.0 Safari/537.36", agents.ChannelStable},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/154.0.0.0 Safari/537.36", agents.ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/155.0.0.0 Safari/537.36", agents.ChannelDev},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/156.0.0.0 Safari/537.36", agents.ChannelCanary},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/155.0.0.0 Safari/537.36 Edg/155.0.3100.0", agents.ChannelDev},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36", agents.ChannelStable},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0a1", agents.ChannelNightly},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:124.0) Gecko/20100101 Firefox/124.0b9", agents.ChannelBeta},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:140.0) Gecko/20100101 Firefox/140.0", agents.ChannelESR},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:141.0) Gecko/20100101 Firefox/141.0", agents.ChannelStable},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:153.0) Gecko/20100101 Firefox/153.0", agents.ChannelESR},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:156.0) Gecko/20100101 Firefox/156.0", agents.ChannelStable},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/28.0 Safari/605.1.15", agents.ChannelPreview},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15", agents.ChannelStable},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36 Vivaldi/6.4.3160.42", ""},
+		{"curl/8.4.0", ""},
+		{"Googlebot", ""},
+	}
+
+	// Chrome 153, Firefox 156 and Safari 27 are the newest releases.
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.Channel, result.BrowserChannelAt(now), "Channel\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
+
+func TestBrowserChannelReleaseDate(t *testing.T) {
+	parser := ua.NewParser()
+	result := parser.Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/144.0.0.0 Safari/537.36")
+
+	assert.Equal(t, agents.ChannelBeta, result.BrowserChannelAt(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, agents.ChannelStable, result.BrowserChannelAt(time.Date(2026, 1, 13, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestWithStableVersions(t *testing.T) {
+	parser := ua.NewParser().WithStableVersions(map[agents.Browser]int{agents.BrowserChrome: 155})
+	chrome := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/155.0.0.0 Safari/537.36"
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, agents.ChannelStable, parser.Parse(chrome).BrowserChannelAt(now))
+	assert.Equal(t, agents.ChannelBeta, parser.Parse(strings.Replace(chrome, "155", "156", 1)).BrowserChannelAt(now))
+
+	// Other parsers still use the release table.
+	assert.Equal(t, agents.ChannelDev, ua.NewParser().Parse(chrome).BrowserChannelAt(now))
+}
@@ -0,0 +1,152 @@
+// Package devices maps raw device model codes, such as those returned by
+// UserAgent.DeviceModel, to their marketing names, release year and form
+// factor.
+//
+// The lookup table is kept separate from the parser so it does not affect
+// the zero allocation parsing path, and is only loaded on first use.
+package devices
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/medama-io/go-useragent/agents"
+)
+
+//go:generate go run ../scripts/devices/main.go devices.csv
+
+// FormFactor represents the physical form of a device.
+type FormFactor string
+
+const (
+	FormFactorPhone    FormFactor = "phone"
+	FormFactorTablet   FormFactor = "tablet"
+	FormFactorFoldable FormFactor = "foldable"
+)
+
+func (f FormFactor) String() string {
+	return string(f)
+}
+
+// Device contains the marketing information for a device model.
+type Device struct {
+	// Model is the model code as stored in the table. Vendors often append
+	// regional or carrier suffixes to a model code, e.g. "SM-G930F" is stored
+	// as "SM-G930".
+	Model      string
+	Vendor     agents.Vendor
+	Name       string
+	Year       int
+	FormFactor FormFactor
+}
+
+// modelSuffixes are the regional and carrier suffixes stripped from a model
+// code when there is no exact match, e.g. "F" for the international
+// "SM-G930F", "FD" for its dual SIM variant or "U1" for the unlocked US
+// "SM-G998U1".
+var modelSuffixes = []string{
+	"A", "AZ", "B", "BD", "DS", "E", "F", "FD", "FN", "J", "K", "L", "N",
+	"P", "Q", "R4", "S", "T", "U", "U1", "V", "W", "X", "0",
+}
+
+//go:embed devices.csv
+var devicesFile string
+
+var (
+	once  sync.Once
+	table map[string]Device
+)
+
+// LookupDevice returns the device information for a model code. The lookup
+// is case insensitive, treats underscores as spaces and also matches model
+// codes with a known regional or carrier suffix.
+func LookupDevice(model string) (Device, bool) {
+	once.Do(func() {
+		devices, err := Parse(devicesFile)
+		if err != nil {
+			// The embedded table is validated when it is generated.
+			panic("devices: invalid embedded table: " + err.Error())
+		}
+
+		table = make(map[string]Device, len(devices))
+		for _, d := range devices {
+			table[NormaliseModel(d.Model)] = d
+		}
+	})
+
+	key := NormaliseModel(model)
+	if key == "" {
+		return Device{}, false
+	}
+
+	if d, ok := table[key]; ok {
+		return d, true
+	}
+
+	// Model names with spaces, e.g. "Pixel 7a", must match exactly.
+	if strings.Contains(key, " ") {
+		return Device{}, false
+	}
+
+	for _, suffix := range modelSuffixes {
+		if base, ok := strings.CutSuffix(key, suffix); ok && base != "" {
+			if d, ok := table[base]; ok {
+				return d, true
+			}
+		}
+	}
+
+	return Device{}, false
+}
+
+// NormaliseModel returns the key a model code is stored and looked up by. It
+// is upper case, with underscores replaced by spaces.
+func NormaliseModel(model string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(model), "_", " "))
+}
+
+// Parse parses a device table in CSV format with the header
+// "model,vendor,name,year,form_factor".
+func Parse(data string) ([]Device, error) {
+	r := csv.NewReader(strings.NewReader(data))
+	r.FieldsPerRecord = 5
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []Device
+	for i, record := range records {
+		// Skip the header.
+		if i == 0 {
+			continue
+		}
+
+		year, err := strconv.Atoi(record[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid year: %w", i+1, err)
+		}
+
+		formFactor := FormFactor(record[4])
+		switch formFactor {
+		case FormFactorPhone, FormFactorTablet, FormFactorFoldable:
+		default:
+			return nil, fmt.Errorf("line %d: unknown form factor %q", i+1, record[4])
+		}
+
+		devices = append(devices, Device{
+			Model:      record[0],
+			Vendor:     agents.Vendor(record[1]),
+			Name:       record[2],
+			Year:       year,
+			FormFactor: formFactor,
+		})
+	}
+
+	return devices, nil
+}
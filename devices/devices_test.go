@@ -0,0 +1,68 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+
+	ua "github.com/medama-io/go-useragent"
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/medama-io/go-useragent/devices"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupDevice(t *testing.T) {
+	cases := []struct {
+		Model      string
+		Found      bool
+		Name       string
+		Year       int
+		FormFactor devices.FormFactor
+	}{
+		{"SM-G930F", true, "Galaxy S7", 2016, devices.FormFactorPhone},
+		{"sm-g930fd", true, "Galaxy S7", 2016, devices.FormFactorPhone},
+		{"SM-F936B", true, "Galaxy Z Fold4", 2022, devices.FormFactorFoldable},
+		{"SM-X700", true, "Galaxy Tab S8", 2022, devices.FormFactorTablet},
+		{"Pixel 7", true, "Pixel 7", 2022, devices.FormFactorPhone},
+		{"Pixel 7a", true, "Pixel 7a", 2023, devices.FormFactorPhone},
+		{"SM-G9300", true, "Galaxy S7", 2016, devices.FormFactorPhone},
+		{"SM-G930U1", true, "Galaxy S7", 2016, devices.FormFactorPhone},
+		{"HTC_Desire_601_dual_sim", true, "Desire 601", 2013, devices.FormFactorPhone},
+		{"HTC Desire 601 dual sim", true, "Desire 601", 2013, devices.FormFactorPhone},
+		{"SM-G930Z", false, "", 0, ""},
+		{"SM-G93", false, "", 0, ""},
+		{"Pixel 7 Lite", false, "", 0, ""},
+		{"SM-G9", false, "", 0, ""},
+		{"", false, "", 0, ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			d, ok := devices.LookupDevice(c.Model)
+			assert.Equal(t, c.Found, ok, "Found\nModel: %s", c.Model)
+			assert.Equal(t, c.Name, d.Name, "Name\nModel: %s", c.Model)
+			assert.Equal(t, c.Year, d.Year, "Year\nModel: %s", c.Model)
+			assert.Equal(t, c.FormFactor, d.FormFactor, "Form Factor\nModel: %s", c.Model)
+		})
+	}
+}
+
+func TestLookupDeviceFromUserAgent(t *testing.T) {
+	agent := ua.NewParser().Parse("Mozilla/5.0 (Linux; Android 6.0.1; SAMSUNG SM-G930F Build/MMB29K) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/4.0 Chrome/44.0.2403.133 Mobile Safari/537.36")
+
+	d, ok := devices.LookupDevice(agent.DeviceModel())
+	assert.True(t, ok)
+	assert.Equal(t, agents.VendorSamsung, d.Vendor)
+	assert.Equal(t, "Galaxy S7", d.Name)
+}
+
+func TestParse(t *testing.T) {
+	_, err := devices.Parse("model,vendor,name,year,form_factor\nX1,Acme,X1,soon,phone\n")
+	assert.Error(t, err)
+
+	_, err = devices.Parse("model,vendor,name,year,form_factor\nX1,Acme,X1,2020,watch\n")
+	assert.Error(t, err)
+
+	list, err := devices.Parse("model,vendor,name,year,form_factor\nX1,Acme,X1,2020,phone\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []devices.Device{{Model: "X1", Vendor: agents.Vendor("Acme"), Name: "X1", Year: 2020, FormFactor: devices.FormFactorPhone}}, list)
+}
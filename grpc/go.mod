@@ -0,0 +1,23 @@
+module github.com/medama-io/go-useragent/grpc
+
+go 1.21.4
+
+require (
+	github.com/medama-io/go-useragent v1.2.1-0.20261019145114-2b09c8d61602
+	github.com/stretchr/testify v1.8.4
+	google.golang.org/grpc v1.67.3
+)
+
+require (
+	github.com/boyter/go-string v1.0.5 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.28.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
+	golang.org/x/text v0.17.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240814211410-ddb44dafa142 // indirect
+	google.golang.org/protobuf v1.34.2 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/medama-io/go-useragent => ../
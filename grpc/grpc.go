@@ -0,0 +1,87 @@
+// Package grpc provides gRPC server interceptors that parse the user agent of
+// each call and store it in the call context.
+//
+// gRPC clients send their user agent in the "user-agent" metadata, usually
+// ending with the client library, e.g. "myservice/2.3.0 grpc-go/1.60.1". These
+// are reported as a Library device with the gRPC client as the browser.
+//
+// Only the "user-agent" and "x-user-agent" metadata are read. Keys with the
+// "grpc-" prefix are reserved by gRPC for its own headers, so a user agent sent
+// under one of them, e.g. "grpc-user-agent", is not supported.
+//
+// It is a separate module so the parser does not depend on gRPC.
+package grpc
+
+import (
+	"context"
+
+	"github.com/medama-io/go-useragent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataKeys are the metadata keys checked for the user agent in order.
+// gRPC-Web proxies forward the client library in "x-user-agent", which is only
+// used if the browser user agent is missing.
+var metadataKeys = []string{"user-agent", "x-user-agent"}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the parsed user agent.
+func NewContext(ctx context.Context, ua useragent.UserAgent) context.Context {
+	return context.WithValue(ctx, contextKey{}, ua)
+}
+
+// FromContext returns the parsed user agent stored by the interceptors, and
+// whether one was found.
+func FromContext(ctx context.Context) (useragent.UserAgent, bool) {
+	ua, ok := ctx.Value(contextKey{}).(useragent.UserAgent)
+	return ua, ok
+}
+
+// UnaryServerInterceptor parses the user agent of each unary call and stores
+// it in the context passed to the handler.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	parser := useragent.NewParser()
+
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(parse(ctx, parser), req)
+	}
+}
+
+// StreamServerInterceptor parses the user agent of each streaming call and
+// stores it in the context of the stream passed to the handler.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	parser := useragent.NewParser()
+
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: parse(ss.Context(), parser)})
+	}
+}
+
+// parse returns a copy of ctx carrying the user agent from the incoming
+// metadata. If there is no user agent, ctx is returned as is.
+func parse(ctx context.Context, parser *useragent.Parser) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	for _, key := range metadataKeys {
+		if values := md.Get(key); len(values) > 0 && values[0] != "" {
+			return NewContext(ctx, parser.Parse(values[0]))
+		}
+	}
+
+	return ctx
+}
+
+// serverStream overrides the context of a grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
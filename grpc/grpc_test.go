@@ -0,0 +1,71 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/medama-io/go-useragent/agents"
+	uagrpc "github.com/medama-io/go-useragent/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := uagrpc.UnaryServerInterceptor()
+
+	cases := []struct {
+		md      metadata.MD
+		browser agents.Browser
+		version string
+	}{
+		{metadata.Pairs("user-agent", "myservice/2.3.0 grpc-go/1.60.1"), agents.BrowserGRPCGo, "1.60.1"},
+		{metadata.Pairs("user-agent", "grpc-java-netty/1.58.0"), agents.BrowserGRPCJava, "1.58.0"},
+		{metadata.Pairs("user-agent", "grpc-node-js/1.9.12"), agents.BrowserGRPCNode, "1.9.12"},
+		{metadata.Pairs("x-user-agent", "grpc-web-javascript/0.1"), agents.BrowserGRPCWeb, "0.1"},
+	}
+
+	for _, c := range cases {
+		ctx := metadata.NewIncomingContext(context.Background(), c.md)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+			ua, ok := uagrpc.FromContext(ctx)
+			require.True(t, ok)
+			assert.Equal(t, c.browser, ua.Browser())
+			assert.Equal(t, c.version, ua.BrowserVersion())
+			assert.True(t, ua.IsLibrary())
+			return nil, nil
+		})
+		require.NoError(t, err)
+	}
+
+	// Calls without metadata are passed through.
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+		_, ok := uagrpc.FromContext(ctx)
+		assert.False(t, ok)
+		return nil, nil
+	})
+	require.NoError(t, err)
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := uagrpc.StreamServerInterceptor()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "grpc-go/1.60.1"))
+	err := interceptor(nil, &fakeStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(_ any, ss grpc.ServerStream) error {
+		ua, ok := uagrpc.FromContext(ss.Context())
+		require.True(t, ok)
+		assert.Equal(t, agents.BrowserGRPCGo, ua.Browser())
+		return nil
+	})
+	require.NoError(t, err)
+}
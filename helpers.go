@@ -22,12 +22,75 @@ func (ua UserAgent) Device() agents.Device {
 	return ua.device.GetMatchDevice()
 }
 
+// Engine returns the rendering engine name. If no engine is found, it returns an empty string.
+func (ua UserAgent) Engine() agents.Engine {
+	return ua.engine.GetMatchEngine()
+}
+
+// EngineVersion returns the rendering engine version. If no version is found, it returns an empty string.
+func (ua UserAgent) EngineVersion() string {
+	return string(ua.engineVersion[:ua.engineVersionIndex])
+}
+
+// Architecture returns the CPU architecture. If no architecture is found, it returns ArchUnknown.
+func (ua UserAgent) Architecture() agents.Architecture {
+	return ua.arch.GetMatchArch()
+}
+
+// Is64Bit returns true if the device is known to have a 64-bit CPU.
+func (ua UserAgent) Is64Bit() bool {
+	return ua.is64Bit
+}
+
+// Raw returns the user agent string that was parsed. It is empty for a
+// UserAgent restored from a Result.
+func (ua UserAgent) Raw() string {
+	return ua.raw
+}
+
+// DeviceModel returns the raw device model code, e.g. "SM-G930F". Apple devices
+// only report the device family, e.g. "iPhone". If no model is found, it returns
+// an empty string.
+func (ua UserAgent) DeviceModel() string {
+	return ua.model
+}
+
+// DeviceVendor returns the device manufacturer, e.g. Samsung. If no vendor is
+// found, it returns an empty string.
+func (ua UserAgent) DeviceVendor() agents.Vendor {
+	return ua.vendor
+}
+
+// AmbiguousIPad returns true if the user agent could belong to either Safari on
+// a Mac or an iPad requesting the desktop site, which send identical user agents.
+// The device is reported as a Mac desktop in this case. Use ParseWithHints with
+// Hints.MaxTouchPoints to resolve it.
+func (ua UserAgent) AmbiguousIPad() bool {
+	return ua.ambiguousIPad
+}
+
 // BrowserVersion returns the browser version. If no version is found, it returns an empty string.
 func (ua UserAgent) BrowserVersion() string {
 	return string(ua.version[:ua.versionIndex])
 }
 
+// Version returns the parsed browser version, which can be compared with other
+// versions. If no version is found, it returns the zero Version.
+func (ua UserAgent) Version() Version {
+	return versionFromRunes(ua.version[:ua.versionIndex])
+}
+
+// OSVersion returns the parsed operating system version, e.g. "17.1" for iOS.
+// Windows reports the NT version, which is "10.0" for both Windows 10 and 11,
+// and the reduced Chrome user agent on Android has no version. If no version
+// is found, it returns the zero Version.
+func (ua UserAgent) OSVersion() Version {
+	return versionFromRunes(ua.osVersion[:ua.osVersionIndex])
+}
+
 // BrowserVersionMajor returns the major version of the browser. If no version is found, it returns an empty string.
+//
+// Deprecated: Use .Version().Major instead.
 func (ua UserAgent) BrowserVersionMajor() string {
 	if ua.versionIndex == 0 {
 		return ""
@@ -39,6 +102,8 @@ func (ua UserAgent) BrowserVersionMajor() string {
 }
 
 // BrowserVersionMinor returns the minor version of the browser. If no version is found, it returns an empty string.
+//
+// Deprecated: Use .Version().Minor instead.
 func (ua UserAgent) BrowserVersionMinor() string {
 	if ua.versionIndex == 0 {
 		return ""
@@ -55,6 +120,8 @@ func (ua UserAgent) BrowserVersionMinor() string {
 }
 
 // BrowserVersionPatch returns the patch version of the browser. If no version is found, it returns an empty string.
+//
+// Deprecated: Use .Version().Patch instead.
 func (ua UserAgent) BrowserVersionPatch() string {
 	if ua.versionIndex == 0 {
 		return ""
@@ -91,11 +158,66 @@ func (ua UserAgent) IsTV() bool {
 	return ua.device == internal.DeviceTV
 }
 
+// IsConsole returns true if the user agent is a games console browser.
+func (ua UserAgent) IsConsole() bool {
+	return ua.device == internal.DeviceConsole
+}
+
+// IsWearable returns true if the user agent is a smartwatch browser.
+func (ua UserAgent) IsWearable() bool {
+	return ua.device == internal.DeviceWearable
+}
+
+// IsXR returns true if the user agent is a virtual or mixed reality headset browser.
+func (ua UserAgent) IsXR() bool {
+	return ua.device == internal.DeviceXR
+}
+
+// IsCar returns true if the user agent is an in-car browser.
+func (ua UserAgent) IsCar() bool {
+	return ua.device == internal.DeviceCar
+}
+
+// IsEReader returns true if the user agent is an e-reader browser.
+func (ua UserAgent) IsEReader() bool {
+	return ua.device == internal.DeviceEReader
+}
+
+// IsSmartSpeaker returns true if the user agent is a smart speaker or smart display.
+func (ua UserAgent) IsSmartSpeaker() bool {
+	return ua.device == internal.DeviceSmartSpeaker
+}
+
 // IsBot returns true if the user agent is a bot.
 func (ua UserAgent) IsBot() bool {
 	return ua.device == internal.DeviceBot
 }
 
+// IsLibrary returns true if the user agent is an HTTP client library or command
+// line tool, such as curl, python-requests or Go-http-client.
+func (ua UserAgent) IsLibrary() bool {
+	return ua.device == internal.DeviceLibrary
+}
+
+// IsAICrawler returns true if the user agent is a known AI/LLM crawler, such as
+// GPTBot, ClaudeBot or CCBot. AI crawlers are also reported as bots by IsBot.
+func (ua UserAgent) IsAICrawler() bool {
+	return ua.aiCrawler
+}
+
+// IsWebView returns true if the user agent is an embedded WebView rather than a
+// standalone browser, such as Android System WebView, iOS WKWebView or any
+// in-app browser.
+func (ua UserAgent) IsWebView() bool {
+	return ua.webView
+}
+
+// InAppBrowser returns the app embedding the browser, such as Facebook or
+// Instagram. If the user agent is not an in-app browser, it returns an empty string.
+func (ua UserAgent) InAppBrowser() agents.App {
+	return ua.app.GetMatchApp()
+}
+
 // GetBrowser returns the browser name. If no browser is found, it returns an empty string.
 //
 // Deprecated: Use .Browser() instead.
@@ -126,7 +248,7 @@ func (ua UserAgent) GetVersion() string {
 
 // GetMajorVersion returns the major version of the browser. If no version is found, it returns an empty string.
 //
-// Deprecated: Use .BrowserVersionMajor() instead.
+// Deprecated: Use .Version().Major instead.
 func (ua UserAgent) GetMajorVersion() string {
 	return ua.BrowserVersionMajor()
 }
@@ -0,0 +1,168 @@
+package useragent
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/medama-io/go-useragent/internal"
+)
+
+// Hints contains the User-Agent Client Hints sent by Chromium based browsers.
+// These are more accurate than the user agent string, which has been frozen
+// for privacy reasons, so they take precedence when available.
+type Hints struct {
+	// Arch is the value of the Sec-CH-UA-Arch header, e.g. "x86" or "arm".
+	Arch string
+	// Bitness is the value of the Sec-CH-UA-Bitness header, e.g. "64".
+	Bitness string
+	// Brands is the value of the Sec-CH-UA-Full-Version-List header, or the
+	// Sec-CH-UA header if the full version list was not requested.
+	Brands []Brand
+	// MaxTouchPoints is the value of navigator.maxTouchPoints reported by the
+	// browser, which has no equivalent header. This is used to tell iPads
+	// requesting the desktop site apart from Macs. Leave nil if unknown.
+	MaxTouchPoints *int
+}
+
+// Brand is a single entry of the Sec-CH-UA brand list, e.g. "Google Chrome"
+// with the version "124.0.6367.91".
+type Brand struct {
+	Name    string
+	Version string
+}
+
+// brandBrowsers maps Client Hints brand names to the browser they identify.
+// Other brands are either GREASE or the shared "Chromium" brand.
+var brandBrowsers = map[string]internal.Match{
+	"Google Chrome":    internal.BrowserChrome,
+	"Microsoft Edge":   internal.BrowserEdge,
+	"Opera":            internal.BrowserOpera,
+	"Samsung Internet": internal.BrowserSamsung,
+	"YaBrowser":        internal.BrowserYandex,
+}
+
+// brandChannel splits a brand name with a pre-release channel suffix into the
+// brand and its channel, e.g. "Microsoft Edge Canary".
+func brandChannel(name string) (string, agents.Channel, bool) {
+	i := strings.LastIndexByte(name, ' ')
+	if i == -1 {
+		return "", "", false
+	}
+
+	switch channel := agents.Channel(name[i+1:]); channel {
+	case agents.ChannelBeta, agents.ChannelDev, agents.ChannelCanary, agents.ChannelNightly:
+		return name[:i], channel, true
+	}
+
+	return "", "", false
+}
+
+// HintsFromHeader returns the User-Agent Client Hints found in the request
+// headers. Missing headers are left empty.
+func HintsFromHeader(h http.Header) Hints {
+	brands := h.Get("Sec-CH-UA-Full-Version-List")
+	if brands == "" {
+		brands = h.Get("Sec-CH-UA")
+	}
+
+	return Hints{
+		Arch:    parseHintString(h.Get("Sec-CH-UA-Arch")),
+		Bitness: parseHintString(h.Get("Sec-CH-UA-Bitness")),
+		Brands:  parseHintBrands(brands),
+	}
+}
+
+// parseHintBrands parses a structured header brand list, e.g.
+// `"Chromium";v="124", "Google Chrome";v="124", "Not-A.Brand";v="99"`.
+func parseHintBrands(v string) []Brand {
+	if v == "" {
+		return nil
+	}
+
+	var brands []Brand
+	for _, item := range strings.Split(v, ",") {
+		name, params, _ := strings.Cut(item, ";")
+		brand := Brand{Name: parseHintString(name)}
+		if _, version, ok := strings.Cut(params, "v="); ok {
+			brand.Version = parseHintString(version)
+		}
+		brands = append(brands, brand)
+	}
+
+	return brands
+}
+
+// parseHintString unquotes a structured header string, e.g. `"x86"`.
+func parseHintString(v string) string {
+	return strings.Trim(strings.TrimSpace(v), `"`)
+}
+
+// ParseWithHints parses a user agent string and merges in the User-Agent
+// Client Hints, returning a UserAgent struct.
+//
+// The brand versions of the full version list are not reduced, so the release
+// channel is resolved from them rather than the user agent string.
+func (p *Parser) ParseWithHints(ua string, hints Hints) UserAgent {
+	agent := p.Trie.Get(ua)
+	agent.normaliseVersion(ua)
+	agent.applyHints(hints)
+	p.setChannel(&agent)
+
+	return agent
+}
+
+// applyHints overrides the values parsed from the user agent string with the
+// User-Agent Client Hints.
+func (ua *UserAgent) applyHints(hints Hints) {
+	for _, brand := range hints.Brands {
+		// The full version list is not reduced like the user agent string,
+		// so it replaces the browser version when it matches the browser.
+		if browser, ok := brandBrowsers[brand.Name]; ok && browser == ua.browser && strings.Contains(brand.Version, ".") {
+			ua.setVersion(brand.Version)
+		}
+
+		// Some pre-release builds append their channel to the brand name,
+		// e.g. "Microsoft Edge Dev".
+		if name, channel, ok := brandChannel(brand.Name); ok && brandBrowsers[name] == ua.browser {
+			ua.channel = channel
+		}
+	}
+
+	// Macs have no touch screen, while iPads report at least 5 touch points.
+	if ua.ambiguousIPad && hints.MaxTouchPoints != nil {
+		if *hints.MaxTouchPoints > 1 {
+			ua.setIPad()
+		} else {
+			ua.ambiguousIPad = false
+		}
+	}
+
+	switch hints.Bitness {
+	case "64":
+		ua.is64Bit = true
+	case "32":
+		ua.is64Bit = false
+	}
+
+	// The architecture hint only contains the CPU family, so we combine it
+	// with the bitness to get the full architecture. Windows on ARM devices
+	// report "x64" in the user agent string, so the hint takes precedence.
+	switch strings.ToLower(hints.Arch) {
+	case "x86":
+		ua.arch = internal.ArchX86
+	case "arm":
+		ua.arch = internal.ArchARM
+	}
+
+	switch {
+	case ua.is64Bit && ua.arch == internal.ArchX86:
+		ua.arch = internal.ArchX86_64
+	case ua.is64Bit && ua.arch == internal.ArchARM:
+		ua.arch = internal.ArchARM64
+	case hints.Bitness == "32" && ua.arch == internal.ArchX86_64:
+		ua.arch = internal.ArchX86
+	case hints.Bitness == "32" && ua.arch == internal.ArchARM64:
+		ua.arch = internal.ArchARM
+	}
+}
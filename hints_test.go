@@ -0,0 +1,143 @@
+package useragent_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	ua "github.com/medama-io/go-useragent"
+
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHintsFromHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set("Sec-CH-UA-Arch", `"arm"`)
+	h.Set("Sec-CH-UA-Bitness", `"64"`)
+
+	assert.Equal(t, ua.Hints{Arch: "arm", Bitness: "64"}, ua.HintsFromHeader(h))
+	assert.Equal(t, ua.Hints{}, ua.HintsFromHeader(http.Header{}))
+
+	h = http.Header{}
+	h.Set("Sec-CH-UA", `"Chromium";v="124", "Google Chrome";v="124", "Not-A.Brand";v="99"`)
+	assert.Equal(t, []ua.Brand{
+		{Name: "Chromium", Version: "124"},
+		{Name: "Google Chrome", Version: "124"},
+		{Name: "Not-A.Brand", Version: "99"},
+	}, ua.HintsFromHeader(h).Brands)
+
+	h.Set("Sec-CH-UA-Full-Version-List", `"Microsoft Edge";v="124.0.2478.80", "Chromium";v="124.0.6367.201"`)
+	assert.Equal(t, []ua.Brand{
+		{Name: "Microsoft Edge", Version: "124.0.2478.80"},
+		{Name: "Chromium", Version: "124.0.6367.201"},
+	}, ua.HintsFromHeader(h).Brands)
+}
+
+func TestParseWithHints(t *testing.T) {
+	parser := ua.NewParser()
+
+	windows := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"
+	mac := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"
+	linux := "Mozilla/5.0 (X11; Linux i686; rv:109.0) Gecko/20100101 Firefox/119.0"
+
+	cases := []struct {
+		UserAgent string
+		Hints     ua.Hints
+		Arch      agents.Architecture
+		Is64Bit   bool
+	}{
+		{windows, ua.Hints{}, agents.ArchX86_64, true},
+		{windows, ua.Hints{Arch: "arm", Bitness: "64"}, agents.ArchARM64, true},
+		{windows, ua.Hints{Arch: "x86", Bitness: "32"}, agents.ArchX86, false},
+		{mac, ua.Hints{Arch: "arm"}, agents.ArchARM64, true},
+		{mac, ua.Hints{Arch: "x86", Bitness: "64"}, agents.ArchX86_64, true},
+		{linux, ua.Hints{Bitness: "64"}, agents.ArchX86_64, true},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.ParseWithHints(c.UserAgent, c.Hints)
+			assert.Equal(t, c.Arch, result.Architecture(), "Architecture\nTest Case: %s\nHints: %+v", c.UserAgent, c.Hints)
+			assert.Equal(t, c.Is64Bit, result.Is64Bit(), "64-bit\nTest Case: %s\nHints: %+v", c.UserAgent, c.Hints)
+		})
+	}
+}
+
+func TestParseWithHintsIPad(t *testing.T) {
+	parser := ua.NewParser()
+
+	safari := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15"
+	none, touch := 0, 5
+
+	cases := []struct {
+		Hints     ua.Hints
+		OS        agents.OS
+		Device    agents.Device
+		Ambiguous bool
+	}{
+		{ua.Hints{}, agents.OSMacOS, agents.DeviceDesktop, true},
+		{ua.Hints{MaxTouchPoints: &none}, agents.OSMacOS, agents.DeviceDesktop, false},
+		{ua.Hints{MaxTouchPoints: &touch}, agents.OSIOS, agents.DeviceTablet, false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.ParseWithHints(safari, c.Hints)
+			assert.Equal(t, c.OS, result.OS(), "OS\nHints: %+v", c.Hints)
+			assert.Equal(t, c.Device, result.Device(), "Device\nHints: %+v", c.Hints)
+			assert.Equal(t, c.Ambiguous, result.AmbiguousIPad(), "Ambiguous iPad\nHints: %+v", c.Hints)
+		})
+	}
+}
+
+func TestParseWithHintsBrands(t *testing.T) {
+	parser := ua.NewParser()
+
+	chrome := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+
+	cases := []struct {
+		Hints   ua.Hints
+		Version string
+	}{
+		{ua.Hints{}, "124.0.0.0"},
+		{ua.Hints{Brands: []ua.Brand{{Name: "Google Chrome", Version: "124"}}}, "124.0.0.0"},
+		{ua.Hints{Brands: []ua.Brand{{Name: "Not-A.Brand", Version: "99.0.0.0"}, {Name: "Google Chrome", Version: "124.0.6367.91"}}}, "124.0.6367.91"},
+		{ua.Hints{Brands: []ua.Brand{{Name: "Microsoft Edge", Version: "125.0.2535.6"}}}, "124.0.0.0"},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.ParseWithHints(chrome, c.Hints)
+			assert.Equal(t, c.Version, result.BrowserVersion(), "Version\nHints: %+v", c.Hints)
+		})
+	}
+}
+
+func TestParseWithHintsChannel(t *testing.T) {
+	edge := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/155.0.0.0 Safari/537.36 Edg/155.0.0.0"
+	parser := ua.NewParser().WithStableVersions(map[agents.Browser]int{agents.BrowserEdge: 155})
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Hints   ua.Hints
+		Channel agents.Channel
+	}{
+		{ua.Hints{}, agents.ChannelStable},
+		{ua.Hints{Brands: []ua.Brand{{Name: "Microsoft Edge Dev", Version: "157"}}}, agents.ChannelDev},
+		{ua.Hints{Brands: []ua.Brand{{Name: "Microsoft Edge Canary", Version: "158"}}}, agents.ChannelCanary},
+		{ua.Hints{Brands: []ua.Brand{{Name: "Google Chrome Beta", Version: "156"}}}, agents.ChannelStable},
+		// The full version list replaces the reduced version before the channel
+		// is compared against the stable version.
+		{ua.Hints{Brands: []ua.Brand{{Name: "Microsoft Edge", Version: "156.0.3200.0"}}}, agents.ChannelBeta},
+		{ua.Hints{Brands: []ua.Brand{{Name: "Microsoft Edge", Version: "155.0.3100.42"}}}, agents.ChannelStable},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.ParseWithHints(edge, c.Hints)
+			assert.Equal(t, c.Channel, result.BrowserChannelAt(now), "Channel\nHints: %+v", c.Hints)
+		})
+	}
+}
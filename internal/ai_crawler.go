@@ -0,0 +1,51 @@
+package internal
+
+import "strings"
+
+// AI crawler tokens are matched directly against the user agent instead of
+// the trie, so any token added to ai_crawlers.txt is detected without also
+// needing a sample user agent in the corpus.
+
+// aiCrawlerTokenIndex groups the AI crawler tokens by their first byte, so we
+// only compare the tokens that could match at the start of each word.
+var aiCrawlerTokenIndex = indexAICrawlerTokens(AICrawlerTokens)
+
+func indexAICrawlerTokens(tokens []string) [256][]string {
+	var index [256][]string
+	for _, t := range tokens {
+		index[t[0]] = append(index[t[0]], t)
+	}
+
+	return index
+}
+
+// IsAICrawlerTokenStart reports whether an AI crawler token can start with b.
+// This is cheaper to check for every word than calling MatchAICrawlerToken.
+func IsAICrawlerTokenStart(b byte) bool {
+	return len(aiCrawlerTokenIndex[b]) != 0
+}
+
+// MatchAICrawlerToken reports whether s starts with an AI crawler token.
+func MatchAICrawlerToken(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, t := range aiCrawlerTokenIndex[s[0]] {
+		if !strings.HasPrefix(s, t) {
+			continue
+		}
+
+		// The token must not be part of a longer word, e.g. "YouBots".
+		if len(s) > len(t) {
+			next := rune(s[len(t)])
+			if IsLetter(next) || IsDigit(next) {
+				continue
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
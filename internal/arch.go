@@ -0,0 +1,98 @@
+package internal
+
+import "strings"
+
+// archTokens are the tokens used to identify the CPU architecture. These
+// contain digits, so they are matched directly against the user agent
+// instead of the trie which has its version numbers stripped.
+//
+// Tokens sharing a prefix are ordered from longest to shortest.
+var archTokens = []archToken{
+	{"x86_64", ArchX86_64},
+	{"x86-64", ArchX86_64},
+	{"x64", ArchX86_64},
+	{"Win64", ArchX86_64},
+	{"WOW64", ArchX86_64},
+	{"amd64", ArchX86_64},
+	{"AMD64", ArchX86_64},
+	{"x86", ArchX86},
+	{"i686", ArchX86},
+	{"i586", ArchX86},
+	{"i386", ArchX86},
+	{"aarch64", ArchARM64},
+	{"arm64", ArchARM64},
+	{"ARM64", ArchARM64},
+	{"arm_64", ArchARM64},
+	{"armv8l", ArchARM},
+	{"armv7l", ArchARM},
+	{"armv6l", ArchARM},
+	{"arm", ArchARM},
+}
+
+type archToken struct {
+	token string
+	match Match
+}
+
+// archTokenIndex groups the architecture tokens by their first byte, so we
+// only compare the tokens that could match at the start of each word.
+var archTokenIndex = indexArchTokens(archTokens)
+
+func indexArchTokens(tokens []archToken) [256][]archToken {
+	var index [256][]archToken
+	for _, t := range tokens {
+		index[t.token[0]] = append(index[t.token[0]], t)
+	}
+
+	return index
+}
+
+// IsArchTokenStart reports whether an architecture token can start with b.
+// This is cheaper to check for every word than calling MatchArchToken.
+func IsArchTokenStart(b byte) bool {
+	return archTokenStart[b]
+}
+
+var archTokenStart = func() [256]bool {
+	var start [256]bool
+	for _, t := range archTokens {
+		start[t.token[0]] = true
+	}
+	start[intelMacToken[0]] = true
+
+	return start
+}()
+
+// intelMacToken is used by all macOS browsers regardless of the actual CPU,
+// so it only tells us the device is 64-bit.
+const intelMacToken = "Intel Mac OS X"
+
+// MatchArchToken matches an architecture token at the start of s. It returns
+// the matched architecture and whether the device is known to be 64-bit.
+func MatchArchToken(s string) (Match, bool) {
+	if len(s) == 0 {
+		return Unknown, false
+	}
+
+	if s[0] == intelMacToken[0] && strings.HasPrefix(s, intelMacToken) {
+		return Unknown, true
+	}
+
+	for _, t := range archTokenIndex[s[0]] {
+		if !strings.HasPrefix(s, t.token) {
+			continue
+		}
+
+		// The token must not be part of a longer word, e.g. "army".
+		if len(s) > len(t.token) {
+			next := rune(s[len(t.token)])
+			if IsLetter(next) || IsDigit(next) {
+				continue
+			}
+		}
+
+		return t.match, t.match.Is64Bit()
+	}
+
+	return Unknown, false
+}
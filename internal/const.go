@@ -1,6 +1,10 @@
 package internal
 
-import "github.com/medama-io/go-useragent/agents"
+import (
+	_ "embed"
+
+	"github.com/medama-io/go-useragent/agents"
+)
 
 type (
 	// Match is an enum for the browser, os or device name.
@@ -16,6 +20,7 @@ const (
 	BrowserAndroid
 	BrowserChrome
 	BrowserEdge
+	BrowserEdgeLegacy
 	BrowserFirefox
 	BrowserIE
 	BrowserOpera
@@ -26,6 +31,54 @@ const (
 	BrowserFalkon
 	BrowserNintendo
 	BrowserYandex
+	BrowserSilk
+	BrowserUC
+	BrowserQQ
+	BrowserHuawei
+	BrowserMIUI
+	BrowserWhale
+	BrowserDuckDuckGo
+	BrowserCocCoc
+	BrowserSogou
+	Browser360
+	BrowserMaxthon
+	BrowserOperaGX
+	BrowserFirefoxFocus
+	BrowserWaterfox
+	BrowserPaleMoon
+	BrowserSeaMonkey
+	BrowserLibreWolf
+	BrowserIceweasel
+	BrowserEpiphany
+	BrowserKonqueror
+	BrowserMidori
+	BrowserQutebrowser
+	BrowserLynx
+	BrowserW3m
+	BrowserLinks
+
+	// HTTP client libraries and command line tools.
+	BrowserJava
+	BrowserCurl
+	BrowserWget
+	BrowserPythonURLLib
+	BrowserGoHTTP
+	BrowserOkHttp
+	BrowserNodeFetch
+	BrowserUndici
+	BrowserAxios
+	BrowserPythonRequests
+	BrowserAIOHTTP
+	BrowserHTTPX
+	BrowserApacheHTTP
+	BrowserGuzzle
+	BrowserLibwwwPerl
+	BrowserPostman
+	BrowserInsomnia
+	BrowserGRPCGo
+	BrowserGRPCJava
+	BrowserGRPCNode
+	BrowserGRPCWeb
 
 	OSAndroid
 	OSChromeOS
@@ -34,32 +87,88 @@ const (
 	OSOpenBSD
 	OSMacOS
 	OSWindows
+	OSFreeBSD
+	OSNetBSD
+	OSTizen
+	OSWebOS
+	OSSailfish
+	OSKaiOS
+	OSFuchsia
+	OSHarmonyOS
+	OSTVOS
+	OSWatchOS
+	OSWindowsPhone
 
 	DeviceDesktop
 	DeviceMobile
 	DeviceTablet
 	DeviceTV
 	DeviceBot
+	DeviceLibrary
+	DeviceConsole
+	DeviceWearable
+	DeviceXR
+	DeviceCar
+	DeviceEReader
+	DeviceSmartSpeaker
+
+	EngineBlink
+	EngineWebKit
+	EngineGecko
+	EngineTrident
+	// There is no match token for EdgeHTML, but it can be inferred from the
+	// Edge Legacy browser token.
+	EngineEdgeHTML
+	EnginePresto
+
+	ArchX86
+	ArchX86_64
+	ArchARM
+	ArchARM64
+
+	AppFacebook
+	AppInstagram
+	AppTikTok
+	AppLinkedIn
+	AppSnapchat
+	AppWeChat
+	AppLine
 
 	TokenVersion
 	// We need a separate type for mobile devices since some user agents use "Mobile/"
 	// appended with a device ID. We need to handle these separately to strip those IDs
 	// out.
 	TokenMobileDevice
+	// AI/LLM crawlers are reported as bots, but are tracked separately so they
+	// can be identified with IsAICrawler.
+	TokenAICrawler
 
 	MatchUnknown MatchType = iota
 	MatchBrowser
 	MatchOS
 	MatchDevice
 	MatchVersion
+	MatchEngine
+	MatchArch
+	MatchApp
 )
 
+// aiCrawlersFile is the curated list of AI/LLM crawler tokens. It is kept as
+// data so the list can be updated without changes to the matcher.
+//
+//go:embed ai_crawlers.txt
+var aiCrawlersFile string
+
+// AICrawlerTokens is the list of tokens identifying AI/LLM crawlers.
+var AICrawlerTokens = ParseTokenList(aiCrawlersFile)
+
 // GetMatchType returns the match type of a match result using the MatchPrecedenceMap.
 func (m Match) GetMatchType() MatchType {
 	switch m {
 	case BrowserAndroid,
 		BrowserChrome,
 		BrowserEdge,
+		BrowserEdgeLegacy,
 		BrowserFirefox,
 		BrowserIE,
 		BrowserOpera,
@@ -69,7 +178,53 @@ func (m Match) GetMatchType() MatchType {
 		BrowserSamsung,
 		BrowserFalkon,
 		BrowserNintendo,
-		BrowserYandex:
+		BrowserYandex,
+		BrowserSilk,
+		BrowserUC,
+		BrowserQQ,
+		BrowserHuawei,
+		BrowserMIUI,
+		BrowserWhale,
+		BrowserDuckDuckGo,
+		BrowserCocCoc,
+		BrowserSogou,
+		Browser360,
+		BrowserMaxthon,
+		BrowserOperaGX,
+		BrowserFirefoxFocus,
+		BrowserWaterfox,
+		BrowserPaleMoon,
+		BrowserSeaMonkey,
+		BrowserLibreWolf,
+		BrowserIceweasel,
+		BrowserEpiphany,
+		BrowserKonqueror,
+		BrowserMidori,
+		BrowserQutebrowser,
+		BrowserLynx,
+		BrowserW3m,
+		BrowserLinks,
+		BrowserJava,
+		BrowserCurl,
+		BrowserWget,
+		BrowserPythonURLLib,
+		BrowserGoHTTP,
+		BrowserOkHttp,
+		BrowserNodeFetch,
+		BrowserUndici,
+		BrowserAxios,
+		BrowserPythonRequests,
+		BrowserAIOHTTP,
+		BrowserHTTPX,
+		BrowserApacheHTTP,
+		BrowserGuzzle,
+		BrowserLibwwwPerl,
+		BrowserPostman,
+		BrowserInsomnia,
+		BrowserGRPCGo,
+		BrowserGRPCJava,
+		BrowserGRPCNode,
+		BrowserGRPCWeb:
 		return MatchBrowser
 
 	case OSAndroid,
@@ -78,7 +233,18 @@ func (m Match) GetMatchType() MatchType {
 		OSLinux,
 		OSOpenBSD,
 		OSMacOS,
-		OSWindows:
+		OSWindows,
+		OSFreeBSD,
+		OSNetBSD,
+		OSTizen,
+		OSWebOS,
+		OSSailfish,
+		OSKaiOS,
+		OSFuchsia,
+		OSHarmonyOS,
+		OSTVOS,
+		OSWatchOS,
+		OSWindowsPhone:
 		return MatchOS
 
 	case DeviceDesktop,
@@ -86,9 +252,40 @@ func (m Match) GetMatchType() MatchType {
 		DeviceTablet,
 		DeviceTV,
 		DeviceBot,
-		TokenMobileDevice:
+		DeviceLibrary,
+		DeviceConsole,
+		DeviceWearable,
+		DeviceXR,
+		DeviceCar,
+		DeviceEReader,
+		DeviceSmartSpeaker,
+		TokenMobileDevice,
+		TokenAICrawler:
 		return MatchDevice
 
+	case EngineBlink,
+		EngineWebKit,
+		EngineGecko,
+		EngineTrident,
+		EngineEdgeHTML,
+		EnginePresto:
+		return MatchEngine
+
+	case ArchX86,
+		ArchX86_64,
+		ArchARM,
+		ArchARM64:
+		return MatchArch
+
+	case AppFacebook,
+		AppInstagram,
+		AppTikTok,
+		AppLinkedIn,
+		AppSnapchat,
+		AppWeChat,
+		AppLine:
+		return MatchApp
+
 	case TokenVersion:
 		return MatchVersion
 	}
@@ -96,6 +293,36 @@ func (m Match) GetMatchType() MatchType {
 	return MatchUnknown
 }
 
+// IsLibrary returns true if the match is an HTTP client library or command line tool.
+func (m Match) IsLibrary() bool {
+	switch m {
+	case BrowserJava,
+		BrowserCurl,
+		BrowserWget,
+		BrowserPythonURLLib,
+		BrowserGoHTTP,
+		BrowserOkHttp,
+		BrowserNodeFetch,
+		BrowserUndici,
+		BrowserAxios,
+		BrowserPythonRequests,
+		BrowserAIOHTTP,
+		BrowserHTTPX,
+		BrowserApacheHTTP,
+		BrowserGuzzle,
+		BrowserLibwwwPerl,
+		BrowserPostman,
+		BrowserInsomnia,
+		BrowserGRPCGo,
+		BrowserGRPCJava,
+		BrowserGRPCNode,
+		BrowserGRPCWeb:
+		return true
+	}
+
+	return false
+}
+
 // GetMatchBrowser returns the browser name of a match.
 func (m Match) GetMatchBrowser() agents.Browser {
 	switch m {
@@ -105,6 +332,8 @@ func (m Match) GetMatchBrowser() agents.Browser {
 		return agents.BrowserChrome
 	case BrowserEdge:
 		return agents.BrowserEdge
+	case BrowserEdgeLegacy:
+		return agents.BrowserEdgeLegacy
 	case BrowserFirefox:
 		return agents.BrowserFirefox
 	case BrowserIE:
@@ -125,6 +354,98 @@ func (m Match) GetMatchBrowser() agents.Browser {
 		return agents.BrowserNintendo
 	case BrowserYandex:
 		return agents.BrowserYandex
+	case BrowserSilk:
+		return agents.BrowserSilk
+	case BrowserUC:
+		return agents.BrowserUC
+	case BrowserQQ:
+		return agents.BrowserQQ
+	case BrowserHuawei:
+		return agents.BrowserHuawei
+	case BrowserMIUI:
+		return agents.BrowserMIUI
+	case BrowserWhale:
+		return agents.BrowserWhale
+	case BrowserDuckDuckGo:
+		return agents.BrowserDuckDuckGo
+	case BrowserCocCoc:
+		return agents.BrowserCocCoc
+	case BrowserSogou:
+		return agents.BrowserSogou
+	case Browser360:
+		return agents.Browser360
+	case BrowserMaxthon:
+		return agents.BrowserMaxthon
+	case BrowserOperaGX:
+		return agents.BrowserOperaGX
+	case BrowserFirefoxFocus:
+		return agents.BrowserFirefoxFocus
+	case BrowserWaterfox:
+		return agents.BrowserWaterfox
+	case BrowserPaleMoon:
+		return agents.BrowserPaleMoon
+	case BrowserSeaMonkey:
+		return agents.BrowserSeaMonkey
+	case BrowserLibreWolf:
+		return agents.BrowserLibreWolf
+	case BrowserIceweasel:
+		return agents.BrowserIceweasel
+	case BrowserEpiphany:
+		return agents.BrowserEpiphany
+	case BrowserKonqueror:
+		return agents.BrowserKonqueror
+	case BrowserMidori:
+		return agents.BrowserMidori
+	case BrowserQutebrowser:
+		return agents.BrowserQutebrowser
+	case BrowserLynx:
+		return agents.BrowserLynx
+	case BrowserW3m:
+		return agents.BrowserW3m
+	case BrowserLinks:
+		return agents.BrowserLinks
+	case BrowserJava:
+		return agents.BrowserJava
+	case BrowserCurl:
+		return agents.BrowserCurl
+	case BrowserWget:
+		return agents.BrowserWget
+	case BrowserPythonURLLib:
+		return agents.BrowserPythonURLLib
+	case BrowserGoHTTP:
+		return agents.BrowserGoHTTP
+	case BrowserOkHttp:
+		return agents.BrowserOkHttp
+	case BrowserNodeFetch:
+		return agents.BrowserNodeFetch
+	case BrowserUndici:
+		return agents.BrowserUndici
+	case BrowserAxios:
+		return agents.BrowserAxios
+	case BrowserPythonRequests:
+		return agents.BrowserPythonRequests
+	case BrowserAIOHTTP:
+		return agents.BrowserAIOHTTP
+	case BrowserHTTPX:
+		return agents.BrowserHTTPX
+	case BrowserApacheHTTP:
+		return agents.BrowserApacheHTTP
+	case BrowserGuzzle:
+		return agents.BrowserGuzzle
+	case BrowserLibwwwPerl:
+		return agents.BrowserLibwwwPerl
+	case BrowserPostman:
+		return agents.BrowserPostman
+	case BrowserInsomnia:
+		return agents.BrowserInsomnia
+	case BrowserGRPCGo:
+		return agents.BrowserGRPCGo
+	case BrowserGRPCJava:
+		return agents.BrowserGRPCJava
+	case BrowserGRPCNode:
+		return agents.BrowserGRPCNode
+	case BrowserGRPCWeb:
+		return agents.BrowserGRPCWeb
 	}
 
 	return ""
@@ -147,6 +468,28 @@ func (m Match) GetMatchOS() agents.OS {
 		return agents.OSMacOS
 	case OSWindows:
 		return agents.OSWindows
+	case OSFreeBSD:
+		return agents.OSFreeBSD
+	case OSNetBSD:
+		return agents.OSNetBSD
+	case OSTizen:
+		return agents.OSTizen
+	case OSWebOS:
+		return agents.OSWebOS
+	case OSSailfish:
+		return agents.OSSailfish
+	case OSKaiOS:
+		return agents.OSKaiOS
+	case OSFuchsia:
+		return agents.OSFuchsia
+	case OSHarmonyOS:
+		return agents.OSHarmonyOS
+	case OSTVOS:
+		return agents.OSTVOS
+	case OSWatchOS:
+		return agents.OSWatchOS
+	case OSWindowsPhone:
+		return agents.OSWindowsPhone
 	}
 
 	return ""
@@ -165,11 +508,88 @@ func (m Match) GetMatchDevice() agents.Device {
 		return agents.DeviceTV
 	case DeviceBot:
 		return agents.DeviceBot
+	case DeviceLibrary:
+		return agents.DeviceLibrary
+	case DeviceConsole:
+		return agents.DeviceConsole
+	case DeviceWearable:
+		return agents.DeviceWearable
+	case DeviceXR:
+		return agents.DeviceXR
+	case DeviceCar:
+		return agents.DeviceCar
+	case DeviceEReader:
+		return agents.DeviceEReader
+	case DeviceSmartSpeaker:
+		return agents.DeviceSmartSpeaker
 	}
 
 	return ""
 }
 
+// GetMatchEngine returns the rendering engine name of a match.
+func (m Match) GetMatchEngine() agents.Engine {
+	switch m {
+	case EngineBlink:
+		return agents.EngineBlink
+	case EngineWebKit:
+		return agents.EngineWebKit
+	case EngineGecko:
+		return agents.EngineGecko
+	case EngineTrident:
+		return agents.EngineTrident
+	case EngineEdgeHTML:
+		return agents.EngineEdgeHTML
+	case EnginePresto:
+		return agents.EnginePresto
+	}
+
+	return ""
+}
+
+// GetMatchArch returns the CPU architecture of a match.
+func (m Match) GetMatchArch() agents.Architecture {
+	switch m {
+	case ArchX86:
+		return agents.ArchX86
+	case ArchX86_64:
+		return agents.ArchX86_64
+	case ArchARM:
+		return agents.ArchARM
+	case ArchARM64:
+		return agents.ArchARM64
+	}
+
+	return agents.ArchUnknown
+}
+
+// GetMatchApp returns the app name of an in-app browser match.
+func (m Match) GetMatchApp() agents.App {
+	switch m {
+	case AppFacebook:
+		return agents.AppFacebook
+	case AppInstagram:
+		return agents.AppInstagram
+	case AppTikTok:
+		return agents.AppTikTok
+	case AppLinkedIn:
+		return agents.AppLinkedIn
+	case AppSnapchat:
+		return agents.AppSnapchat
+	case AppWeChat:
+		return agents.AppWeChat
+	case AppLine:
+		return agents.AppLine
+	}
+
+	return ""
+}
+
+// Is64Bit returns true if the match is a 64-bit CPU architecture.
+func (m Match) Is64Bit() bool {
+	return m == ArchX86_64 || m == ArchARM64
+}
+
 // GetMatchName returns the name of a match. This is used for debugging in tests.
 func (m Match) GetMatchName() string {
 	if browser := m.GetMatchBrowser(); browser != "" {
@@ -184,12 +604,50 @@ func (m Match) GetMatchName() string {
 		return device.String()
 	}
 
+	if engine := m.GetMatchEngine(); engine != "" {
+		return engine.String()
+	}
+
+	if m.GetMatchType() == MatchArch {
+		return m.GetMatchArch().String()
+	}
+
+	if app := m.GetMatchApp(); app != "" {
+		return app.String()
+	}
+
 	switch m {
 	case TokenVersion:
 		return "Version"
 	case TokenMobileDevice:
 		return "MobileDevice"
+	case TokenAICrawler:
+		return "AICrawler"
 	}
 
 	return ""
 }
+
+// matchesByName maps the name of each match back to the match for each match
+// type, which is used to restore unmarshalled results.
+var matchesByName = func() map[MatchType]map[string]Match {
+	names := map[MatchType]map[string]Match{}
+	for m := Match(1); m != Unknown; m++ {
+		t := m.GetMatchType()
+		if t == MatchUnknown || t == MatchVersion {
+			continue
+		}
+		if names[t] == nil {
+			names[t] = map[string]Match{}
+		}
+		names[t][m.GetMatchName()] = m
+	}
+	return names
+}()
+
+// MatchFromName returns the match of the given type with the name returned by
+// GetMatchName, e.g. "Chrome" for MatchBrowser. If there is no such match, it
+// returns Unknown.
+func MatchFromName(t MatchType, name string) Match {
+	return matchesByName[t][name]
+}
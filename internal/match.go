@@ -2,6 +2,7 @@ package internal
 
 import (
 	"sort"
+	"strings"
 
 	str "github.com/boyter/go-string"
 	"github.com/medama-io/go-useragent/agents"
@@ -14,35 +15,117 @@ import (
 // most important match.
 var matchMap = map[Match][]string{
 	// Browsers
-	BrowserChrome:    {"CriOS", string(agents.BrowserChrome)},
-	BrowserEdge:      {"EdgiOS", string(agents.BrowserEdge), "Edg"},
-	BrowserFirefox:   {"FxiOS", string(agents.BrowserFirefox)},
-	BrowserIE:        {"MSIE", "Trident"},
-	BrowserOpera:     {"OPiOS", "OPR", string(agents.BrowserOpera)},
-	BrowserOperaMini: {"Mini"},
-	BrowserSafari:    {string(agents.BrowserSafari), "AppleWebKit"},
-	BrowserVivaldi:   {string(agents.BrowserVivaldi)},
-	BrowserSamsung:   {"SamsungBrowser"},
-	BrowserFalkon:    {string(agents.BrowserFalkon)},
-	BrowserNintendo:  {"NintendoBrowser"},
-	BrowserYandex:    {"YaBrowser"},
+	BrowserChrome:       {"CriOS", string(agents.BrowserChrome)},
+	BrowserEdge:         {"EdgiOS", "EdgA", "Edg"},
+	BrowserEdgeLegacy:   {"Edge"},
+	BrowserFirefox:      {"FxiOS", string(agents.BrowserFirefox)},
+	BrowserIE:           {"MSIE", "Trident"},
+	BrowserOpera:        {"OPiOS", "OPR", string(agents.BrowserOpera)},
+	BrowserOperaMini:    {"Mini"},
+	BrowserSafari:       {string(agents.BrowserSafari), "AppleWebKit"},
+	BrowserVivaldi:      {string(agents.BrowserVivaldi)},
+	BrowserSamsung:      {"SamsungBrowser"},
+	BrowserFalkon:       {string(agents.BrowserFalkon)},
+	BrowserNintendo:     {"NintendoBrowser"},
+	BrowserYandex:       {"YaBrowser"},
+	BrowserSilk:         {"Silk"},
+	BrowserUC:           {"UCBrowser", "UCMini"},
+	BrowserQQ:           {"MQQBrowser", "QQBrowser"},
+	BrowserHuawei:       {"HuaweiBrowser"},
+	BrowserMIUI:         {"MiuiBrowser"},
+	BrowserWhale:        {"Whale"},
+	BrowserDuckDuckGo:   {"DuckDuckGo", "Ddg"},
+	BrowserCocCoc:       {"coc_coc_browser", "coccocbrowser"},
+	BrowserSogou:        {"SogouMobile", "MetaSr"},
+	Browser360:          {"QihooBrowser", "QHBrowser", "QIHU"},
+	BrowserMaxthon:      {"MXiOS", "Maxthon"},
+	BrowserOperaGX:      {"OPX"},
+	BrowserFirefoxFocus: {"Focus", "Klar"},
+	BrowserWaterfox:     {"Waterfox"},
+	BrowserPaleMoon:     {"PaleMoon"},
+	BrowserSeaMonkey:    {"SeaMonkey"},
+	BrowserLibreWolf:    {"LibreWolf"},
+	BrowserIceweasel:    {"Iceweasel"},
+	BrowserEpiphany:     {"Epiphany"},
+	BrowserKonqueror:    {"Konqueror"},
+	BrowserMidori:       {"Midori"},
+	BrowserQutebrowser:  {"qutebrowser"},
+	BrowserLynx:         {"Lynx"},
+	BrowserW3m:          {"w3m"},
+	BrowserLinks:        {"Links"},
+
+	// HTTP client libraries and command line tools.
+	BrowserJava:           {"Java"},
+	BrowserCurl:           {"curl"},
+	BrowserWget:           {"Wget"},
+	BrowserPythonURLLib:   {"Python-urllib", "Pythonurllib"},
+	BrowserGoHTTP:         {"Go-http-client", "Gohttpclient"},
+	BrowserOkHttp:         {"okhttp"},
+	BrowserNodeFetch:      {"node-fetch", "nodefetch"},
+	BrowserUndici:         {"undici"},
+	BrowserAxios:          {"axios"},
+	BrowserPythonRequests: {"python-requests", "pythonrequests"},
+	BrowserAIOHTTP:        {"aiohttp"},
+	BrowserHTTPX:          {"python-httpx", "pythonhttpx"},
+	BrowserApacheHTTP:     {"Apache-HttpClient", "ApacheHttpClient"},
+	BrowserGuzzle:         {"GuzzleHttp"},
+	BrowserLibwwwPerl:     {"libwww-perl", "libwwwperl"},
+	BrowserPostman:        {"PostmanRuntime"},
+	BrowserInsomnia:       {"insomnia"},
+	BrowserGRPCGo:         {"grpc-go", "grpcgo"},
+	BrowserGRPCJava:       {"grpc-java-netty", "grpc-java-okhttp", "grpc-java-cronet", "grpcjavanetty", "grpcjavaokhttp", "grpcjavacronet"},
+	BrowserGRPCNode:       {"grpc-node-js", "grpc-node", "grpcnodejs", "grpcnode"},
+	BrowserGRPCWeb:        {"grpc-web-javascript", "grpcwebjavascript"},
 
 	// Operating Systems
-	OSAndroid:  {string(agents.OSAndroid)},
-	OSChromeOS: {"CrOS"},
-	OSIOS:      {"iPhone", "iPad", "iPod"},
-	OSLinux:    {string(agents.OSLinux), "Ubuntu", "Fedora"},
-	OSOpenBSD:  {string(agents.OSOpenBSD)},
-	OSMacOS:    {"Macintosh"},
-	OSWindows:  {"Windows NT", "WindowsNT"},
+	OSAndroid:      {string(agents.OSAndroid)},
+	OSChromeOS:     {"CrOS"},
+	OSIOS:          {"iPhone", "iPad", "iPod"},
+	OSLinux:        {string(agents.OSLinux), "Ubuntu", "Fedora"},
+	OSOpenBSD:      {string(agents.OSOpenBSD)},
+	OSMacOS:        {"Macintosh"},
+	OSWindows:      {"Windows NT", "WindowsNT"},
+	OSFreeBSD:      {"FreeBSD"},
+	OSNetBSD:       {"NetBSD"},
+	OSTizen:        {"Tizen"},
+	OSWebOS:        {"webOS", "WebOS"},
+	OSSailfish:     {"Sailfish"},
+	OSKaiOS:        {"KAIOS", "KaiOS"},
+	OSFuchsia:      {"Fuchsia"},
+	OSHarmonyOS:    {"OpenHarmony", "HarmonyOS"},
+	OSTVOS:         {"tvOS", "AppleTV", "Apple TV"},
+	OSWatchOS:      {"watchOS", "Watch OS", "WatchOS"},
+	OSWindowsPhone: {"Windows Phone", "WindowsPhone", "Windows Mobile", "WindowsMobile"},
 
 	// Devices
-	DeviceDesktop:     {string(agents.DeviceDesktop), "Ubuntu", "Fedora"},
-	DeviceMobile:      {string(agents.DeviceMobile)},
-	TokenMobileDevice: {"ONEPLUS", "Huawei", "HTC", "Galaxy", "iPhone", "iPod", "Windows Phone", "WindowsPhone", "LG"},
-	DeviceTablet:      {string(agents.DeviceTablet), "Touch", "iPad", "Nintendo Switch", "NintendoSwitch", "Kindle"},
-	DeviceTV:          {string(agents.DeviceTV), "Large Screen", "LargeScreen", "Smart Display", "SmartDisplay", "PLAYSTATION", "PlayStation", "ADT-2", "ADT-1", "CrKey", "Roku", "AFT", "Web0S", "Nexus Player", "Xbox", "XBOX", "Nintendo WiiU", "NintendoWiiU"},
-	DeviceBot:         {string(agents.DeviceBot), "HeadlessChrome", "bot", "Slurp", "LinkCheck", "QuickLook", "Haosou", "Yahoo Ad", "YahooAd", "Google", "Mediapartners", "Headless", "facebookexternalhit", "facebookcatalog", "Baidu", "Instagram", "Pinterest", "PageSpeedInsights", "WhatsApp"},
+	DeviceDesktop:      {string(agents.DeviceDesktop), "Ubuntu", "Fedora"},
+	DeviceMobile:       {string(agents.DeviceMobile)},
+	TokenMobileDevice:  {"ONEPLUS", "Huawei", "HTC", "Galaxy", "iPhone", "iPod", "Windows Phone", "WindowsPhone", "LG"},
+	DeviceTablet:       {string(agents.DeviceTablet), "Touch", "iPad"},
+	DeviceTV:           {string(agents.DeviceTV), "Large Screen", "LargeScreen", "ADT-2", "ADT-1", "CrKey", "Roku", "AFT", "Web0S", "Nexus Player"},
+	DeviceConsole:      {"PLAYSTATION", "PlayStation", "Xbox", "XBOX", "Nintendo"},
+	DeviceWearable:     {"watchOS"},
+	DeviceXR:           {"OculusBrowser", "Quest", "PicoBrowser", "Mobile VR", "MobileVR", "visionOS"},
+	DeviceCar:          {"Tesla", "QtCarBrowser"},
+	DeviceEReader:      {"Kindle", "Kobo", "PocketBook"},
+	DeviceSmartSpeaker: {"Smart Display", "SmartDisplay", "AlexaMediaPlayer"},
+	DeviceBot:          {string(agents.DeviceBot), "HeadlessChrome", "bot", "Slurp", "LinkCheck", "QuickLook", "Haosou", "Yahoo Ad", "YahooAd", "Google", "Mediapartners", "Headless", "facebookexternalhit", "facebookcatalog", "Baidu", "Pinterest", "PageSpeedInsights", "WhatsApp"},
+
+	// Engines
+	EngineBlink:   {string(agents.BrowserChrome)},
+	EngineWebKit:  {"AppleWebKit"},
+	EngineGecko:   {string(agents.EngineGecko)},
+	EngineTrident: {string(agents.EngineTrident)},
+	EnginePresto:  {string(agents.EnginePresto)},
+
+	// In-app browsers
+	AppFacebook:  {"FBAN", "FB_IAB", "FBIAB", "FBAV"},
+	AppInstagram: {"Instagram"},
+	AppTikTok:    {"musical_ly", "musically", "BytedanceWebview"},
+	AppLinkedIn:  {"LinkedInApp"},
+	AppSnapchat:  {"Snapchat"},
+	AppWeChat:    {"MicroMessenger"},
+	AppLine:      {"Line/"},
 
 	// Version
 	TokenVersion: {"Version"},
@@ -60,19 +143,70 @@ var matchMap = map[Match][]string{
 // and use that as the final result.
 var MatchPrecedenceMap = map[Match]uint8{
 	// Browsers
-	BrowserSafari:    1, // Is always at the end of a Chrome user agent.
-	BrowserAndroid:   2,
-	BrowserChrome:    3,
-	BrowserFirefox:   4,
-	BrowserIE:        5,
-	BrowserOpera:     6,
-	BrowserOperaMini: 7,
-	BrowserEdge:      8,
-	BrowserVivaldi:   9,
-	BrowserSamsung:   10,
-	BrowserFalkon:    11,
-	BrowserNintendo:  12,
-	BrowserYandex:    13,
+	BrowserJava:         1, // Is also found in embedded browsers.
+	BrowserSafari:       2, // Is always at the end of a Chrome user agent.
+	BrowserAndroid:      3,
+	BrowserChrome:       4,
+	BrowserFirefox:      5,
+	BrowserIE:           6,
+	BrowserOpera:        7,
+	BrowserOperaMini:    8,
+	BrowserEdge:         9,
+	BrowserEdgeLegacy:   10, // Must be higher than Edge, as "Edg" is a prefix of "Edge".
+	BrowserVivaldi:      11,
+	BrowserSamsung:      12,
+	BrowserFalkon:       13,
+	BrowserNintendo:     14,
+	BrowserYandex:       15,
+	BrowserSilk:         16, // Is combined with the Chrome token.
+	BrowserUC:           17,
+	BrowserQQ:           18,
+	BrowserHuawei:       19,
+	BrowserMIUI:         20,
+	BrowserWhale:        21,
+	BrowserDuckDuckGo:   22,
+	BrowserCocCoc:       23,
+	BrowserSogou:        24,
+	Browser360:          25,
+	BrowserMaxthon:      26,
+	BrowserOperaGX:      27, // Is combined with the Opera token.
+	BrowserFirefoxFocus: 28,
+	BrowserWaterfox:     29, // Gecko forks include the Firefox token after their own.
+	BrowserPaleMoon:     30,
+	BrowserSeaMonkey:    31,
+	BrowserLibreWolf:    32,
+	BrowserIceweasel:    33,
+	BrowserEpiphany:     34,
+	BrowserKonqueror:    35,
+	BrowserMidori:       36,
+	BrowserQutebrowser:  37,
+	BrowserLynx:         38,
+	BrowserW3m:          39,
+	BrowserLinks:        40,
+
+	// HTTP client libraries and command line tools take precedence over
+	// browsers, as they are rarely combined with browser tokens. Java is the
+	// exception as it is also found in embedded browsers such as JavaFX.
+	BrowserCurl:           41,
+	BrowserWget:           42,
+	BrowserPythonURLLib:   43,
+	BrowserGoHTTP:         44,
+	BrowserOkHttp:         45,
+	BrowserNodeFetch:      46,
+	BrowserUndici:         47,
+	BrowserAxios:          48,
+	BrowserPythonRequests: 49,
+	BrowserAIOHTTP:        50,
+	BrowserHTTPX:          51,
+	BrowserApacheHTTP:     52,
+	BrowserGuzzle:         53,
+	BrowserLibwwwPerl:     54,
+	BrowserPostman:        55,
+	BrowserInsomnia:       56,
+	BrowserGRPCGo:         57,
+	BrowserGRPCJava:       58,
+	BrowserGRPCNode:       59,
+	BrowserGRPCWeb:        60,
 
 	// Operating Systems
 	OSLinux:    1,
@@ -82,6 +216,20 @@ var MatchPrecedenceMap = map[Match]uint8{
 	OSChromeOS: 5,
 	OSMacOS:    6,
 	OSWindows:  7,
+	// Less common platforms often include a more generic token, e.g. Tizen
+	// includes Linux and Windows Phone includes Android and iPhone, so they
+	// take precedence over the mainstream ones.
+	OSFreeBSD:      8,
+	OSNetBSD:       9,
+	OSTizen:        10,
+	OSWebOS:        11,
+	OSSailfish:     12,
+	OSKaiOS:        13,
+	OSFuchsia:      14,
+	OSHarmonyOS:    15,
+	OSTVOS:         16,
+	OSWatchOS:      17,
+	OSWindowsPhone: 18,
 
 	// Types
 	DeviceDesktop:     1,
@@ -89,7 +237,35 @@ var MatchPrecedenceMap = map[Match]uint8{
 	TokenMobileDevice: 3,
 	DeviceTablet:      4,
 	DeviceTV:          5,
-	DeviceBot:         6,
+	// More specific device classes take precedence over the generic form
+	// factor tokens they are often combined with, e.g. "Mobile VR".
+	DeviceEReader:      6,
+	DeviceWearable:     7,
+	DeviceXR:           8,
+	DeviceConsole:      9,
+	DeviceCar:          10,
+	DeviceSmartSpeaker: 11, // Is combined with the CrKey TV token.
+	DeviceBot:          12,
+	// AI crawlers take precedence over generic bot tokens, as most of them
+	// also contain "bot" in their name.
+	TokenAICrawler: 13,
+
+	// Engines
+	EngineGecko:    1, // Is found in most user agents as "like Gecko".
+	EngineWebKit:   2, // Is always before the Chrome token in a Blink user agent.
+	EngineBlink:    3,
+	EnginePresto:   4,
+	EngineTrident:  5,
+	EngineEdgeHTML: 6,
+
+	// In-app browsers
+	AppFacebook:  1, // Other Meta apps may include the Facebook tokens.
+	AppInstagram: 2,
+	AppTikTok:    3,
+	AppLinkedIn:  4,
+	AppSnapchat:  5,
+	AppWeChat:    6,
+	AppLine:      7,
 }
 
 // MatchResults contains the information from MatchTokenIndexes.
@@ -101,6 +277,10 @@ type MatchResults struct {
 	// Higher values overwrite lower values.
 	Precedence uint8
 
+	// Product is set for tokens ending with a slash, which are only matched
+	// when followed by one, e.g. "Line/13.21.0" but not "Linespider".
+	Product bool
+
 	EndIndex int
 }
 
@@ -118,7 +298,10 @@ func MatchTokenIndexes(ua string) []MatchResults {
 				continue
 			}
 
-			indexes := str.IndexAll(ua, m, -1)
+			// Slashes are removed from the corpus, so the token is matched
+			// without it and the slash is checked when parsing.
+			token, product := strings.CutSuffix(m, "/")
+			indexes := str.IndexAll(ua, token, -1)
 
 			// Return the last match.
 			if len(indexes) == 0 {
@@ -129,7 +312,7 @@ func MatchTokenIndexes(ua string) []MatchResults {
 
 			// Add the match to the results.
 			matchType := key.GetMatchType()
-			results = append(results, MatchResults{EndIndex: lastIndex[1], Match: key, MatchType: matchType, Precedence: MatchPrecedenceMap[key]})
+			results = append(results, MatchResults{EndIndex: lastIndex[1], Match: key, MatchType: matchType, Precedence: MatchPrecedenceMap[key], Product: product})
 			exists[key] = true
 		}
 	}
@@ -149,3 +332,29 @@ func MatchTokenIndexes(ua string) []MatchResults {
 
 	return results
 }
+
+// browserTokens maps each browser token to its browser. If browsers share a
+// token, the browser with the highest precedence is kept.
+var browserTokens = func() map[string]Match {
+	tokens := make(map[string]Match)
+	for match, list := range matchMap {
+		if match.GetMatchType() != MatchBrowser {
+			continue
+		}
+		for _, token := range list {
+			if prev, ok := tokens[token]; !ok || MatchPrecedenceMap[match] > MatchPrecedenceMap[prev] {
+				tokens[token] = match
+			}
+		}
+	}
+	return tokens
+}()
+
+// MatchBrowserToken returns the browser of a complete token, e.g. "Edge" for
+// Edge Legacy. This is used when a shorter token is matched at the start of a
+// longer one, e.g. "Edg" in "Edge/18", and the trie has no path to the longer
+// token.
+func MatchBrowserToken(token string) (Match, bool) {
+	match, ok := browserTokens[token]
+	return match, ok
+}
@@ -11,76 +11,155 @@ import (
 
 var matchResults = [][]internal.Match{
 	// Windows (7)
-	{internal.BrowserSafari, internal.BrowserChrome, internal.OSWindows},
-	{internal.BrowserSafari, internal.BrowserChrome, internal.OSWindows},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSWindows},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSWindows},
+	{internal.EngineTrident, internal.OSWindows, internal.BrowserIE},
+	{internal.EngineTrident, internal.OSWindows, internal.BrowserIE},
+	{internal.EngineGecko, internal.BrowserIE, internal.EngineTrident, internal.OSWindows},
 	{internal.OSWindows, internal.BrowserIE},
-	{internal.OSWindows, internal.BrowserIE},
-	{internal.BrowserIE, internal.OSWindows},
-	{internal.OSWindows, internal.BrowserIE},
-	{internal.BrowserEdge, internal.BrowserSafari, internal.BrowserChrome, internal.OSWindows},
+	{internal.BrowserEdgeLegacy, internal.BrowserEdge, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSWindows},
 
 	// Mac (5)
-	{internal.BrowserSafari, internal.TokenVersion, internal.OSMacOS},
-	{internal.BrowserSafari, internal.BrowserChrome, internal.OSMacOS},
-	{internal.BrowserFirefox, internal.OSMacOS},
-	{internal.BrowserVivaldi, internal.BrowserSafari, internal.BrowserChrome, internal.OSMacOS},
-	{internal.BrowserEdge, internal.BrowserSafari, internal.BrowserChrome, internal.OSMacOS},
+	{internal.BrowserSafari, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSMacOS},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSMacOS},
+	{internal.BrowserFirefox, internal.EngineGecko, internal.OSMacOS},
+	{internal.BrowserVivaldi, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSMacOS},
+	{internal.BrowserEdge, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSMacOS},
 
 	// Linux (5)
-	{internal.BrowserFirefox, internal.OSLinux},
-	{internal.BrowserFirefox, internal.OSLinux},
-	{internal.BrowserFirefox, internal.OSLinux, internal.DeviceDesktop},
-	{internal.BrowserFirefox, internal.OSLinux, internal.DeviceDesktop},
-	{internal.BrowserSafari, internal.BrowserChrome, internal.OSLinux},
+	{internal.BrowserFirefox, internal.EngineGecko, internal.OSLinux},
+	{internal.BrowserFirefox, internal.EngineGecko, internal.OSLinux},
+	{internal.BrowserFirefox, internal.EngineGecko, internal.OSLinux, internal.DeviceDesktop},
+	{internal.BrowserFirefox, internal.EngineGecko, internal.OSLinux, internal.DeviceDesktop},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSLinux},
 
 	// iPhone (5)
-	{internal.BrowserSafari, internal.DeviceMobile, internal.TokenVersion, internal.OSIOS, internal.TokenMobileDevice},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.OSIOS, internal.TokenMobileDevice},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserOpera, internal.OSIOS, internal.TokenMobileDevice},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserFirefox, internal.OSIOS, internal.TokenMobileDevice},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserEdge, internal.TokenVersion, internal.OSIOS, internal.TokenMobileDevice},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSIOS, internal.TokenMobileDevice},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineGecko, internal.EngineWebKit, internal.OSIOS, internal.TokenMobileDevice},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserOpera, internal.EngineGecko, internal.EngineWebKit, internal.OSIOS, internal.TokenMobileDevice},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserFirefox, internal.EngineGecko, internal.EngineWebKit, internal.OSIOS, internal.TokenMobileDevice},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserEdge, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSIOS, internal.TokenMobileDevice},
 
 	// iPad (3)
-	{internal.BrowserSafari, internal.DeviceMobile, internal.TokenVersion, internal.OSIOS, internal.DeviceTablet},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.OSIOS, internal.DeviceTablet},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserFirefox, internal.OSIOS, internal.DeviceTablet},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSIOS, internal.DeviceTablet},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineGecko, internal.EngineWebKit, internal.OSIOS, internal.DeviceTablet},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserFirefox, internal.EngineGecko, internal.EngineWebKit, internal.OSIOS, internal.DeviceTablet},
 
 	// Android (4)
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.BrowserSamsung, internal.OSAndroid, internal.OSLinux},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.TokenVersion, internal.OSAndroid, internal.OSLinux},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.TokenVersion, internal.OSAndroid, internal.OSLinux},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.TokenVersion, internal.TokenMobileDevice, internal.OSAndroid, internal.OSLinux},
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.BrowserSamsung, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.TokenMobileDevice, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
 
 	// Bots (4)
 	{internal.DeviceBot},
 	{internal.DeviceBot},
 	{internal.DeviceBot},
 	{internal.DeviceBot},
-	{internal.BrowserSafari, internal.BrowserChrome, internal.DeviceBot},
-	{internal.BrowserSafari, internal.BrowserChrome, internal.DeviceBot, internal.OSLinux},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.DeviceBot, internal.EngineGecko, internal.EngineWebKit},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.DeviceBot, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSLinux},
 
 	// Yandex Browser (1)
-	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserYandex, internal.BrowserChrome, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserYandex, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
 
 	// Safari UIWebView (1)
-	{internal.DeviceMobile, internal.BrowserSafari, internal.OSIOS, internal.TokenMobileDevice},
+	{internal.DeviceMobile, internal.EngineGecko, internal.BrowserSafari, internal.EngineWebKit, internal.OSIOS, internal.TokenMobileDevice},
 
 	// Falkon (1)
-	{internal.BrowserSafari, internal.BrowserChrome, internal.BrowserFalkon, internal.OSLinux},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.BrowserFalkon, internal.EngineGecko, internal.EngineWebKit, internal.OSLinux},
 
 	// Android Firefox (1)
-	{internal.BrowserFirefox, internal.DeviceMobile, internal.OSAndroid},
+	{internal.BrowserFirefox, internal.EngineGecko, internal.DeviceMobile, internal.OSAndroid},
 
 	// Linux ARM Architecture (1)
-	{internal.BrowserSafari, internal.BrowserChrome, internal.OSLinux},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSLinux},
 
 	// Samsung Browser
-	{internal.BrowserSafari, internal.DeviceTV, internal.BrowserChrome, internal.BrowserSamsung, internal.OSLinux},
-	{internal.BrowserSafari, internal.BrowserChrome, internal.BrowserSamsung, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceTV, internal.BrowserChrome, internal.EngineBlink, internal.BrowserSamsung, internal.EngineGecko, internal.EngineWebKit, internal.OSTizen, internal.OSLinux},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.BrowserSamsung, internal.EngineGecko, internal.EngineWebKit, internal.OSLinux},
 
 	// OpenBSD
-	{internal.BrowserFirefox, internal.OSOpenBSD},
+	{internal.BrowserFirefox, internal.EngineGecko, internal.OSOpenBSD},
+
+	// AI Crawlers
+	{internal.DeviceBot, internal.EngineGecko, internal.BrowserSafari, internal.EngineWebKit},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+
+	// HTTP Libraries
+	{internal.BrowserCurl},
+	{internal.BrowserPythonRequests},
+	{internal.BrowserGoHTTP},
+	{internal.BrowserOkHttp},
+	{internal.BrowserPostman},
+	{internal.BrowserJava},
+
+	// gRPC Clients
+	{internal.BrowserGRPCGo},
+	{internal.BrowserGRPCJava},
+	{internal.BrowserGRPCNode},
+	{internal.BrowserGRPCWeb},
+
+	// Chromium forks and regional browsers
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserUC, internal.BrowserChrome, internal.EngineBlink, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserQQ, internal.BrowserChrome, internal.EngineBlink, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserHuawei, internal.TokenMobileDevice, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserMIUI, internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.BrowserWhale, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSWindows},
+	{internal.BrowserDuckDuckGo, internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserDuckDuckGo, internal.BrowserSafari, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSMacOS},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.BrowserSilk, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.BrowserCocCoc, internal.EngineGecko, internal.EngineWebKit, internal.OSWindows},
+	{internal.BrowserSogou, internal.DeviceMobile, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.Browser360, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSWindows},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserMaxthon, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSIOS, internal.TokenMobileDevice},
+	{internal.BrowserOperaGX, internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserEdge, internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.BrowserFirefoxFocus, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.BrowserFirefoxFocus, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSLinux},
+
+	// Other operating systems
+	{internal.BrowserFirefox, internal.EngineGecko, internal.OSFreeBSD},
+	{internal.BrowserFirefox, internal.EngineGecko, internal.OSNetBSD},
+	{internal.BrowserEdgeLegacy, internal.BrowserEdge, internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSAndroid, internal.OSWindowsPhone, internal.TokenMobileDevice},
+	{internal.DeviceTablet, internal.DeviceMobile, internal.EngineTrident, internal.OSWindowsPhone, internal.TokenMobileDevice, internal.BrowserIE},
+	{internal.OSKaiOS, internal.BrowserFirefox, internal.EngineGecko, internal.DeviceMobile},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserSamsung, internal.EngineGecko, internal.EngineWebKit, internal.OSTizen, internal.OSLinux},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.DeviceTV, internal.OSLinux},
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserHuawei, internal.TokenMobileDevice, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSHarmonyOS, internal.OSAndroid, internal.OSLinux},
+	{internal.BrowserHuawei, internal.TokenMobileDevice, internal.DeviceMobile, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSHarmonyOS},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSFuchsia},
+	{internal.OSSailfish, internal.BrowserFirefox, internal.EngineGecko, internal.DeviceMobile, internal.OSLinux},
+	{internal.EngineGecko, internal.BrowserSafari, internal.EngineWebKit, internal.OSTVOS, internal.DeviceTV},
+	{internal.EngineGecko, internal.BrowserSafari, internal.EngineWebKit, internal.OSWatchOS, internal.DeviceWearable},
+
+	// Gecko forks and niche desktop browsers
+	{internal.BrowserWaterfox, internal.BrowserFirefox, internal.EngineGecko, internal.OSWindows},
+	{internal.BrowserPaleMoon, internal.BrowserFirefox, internal.EngineGecko, internal.OSWindows},
+	{internal.BrowserFirefox, internal.BrowserPaleMoon, internal.EngineGecko, internal.OSWindows},
+	{internal.BrowserSeaMonkey, internal.BrowserFirefox, internal.EngineGecko, internal.OSWindows},
+	{internal.BrowserLibreWolf, internal.BrowserFirefox, internal.EngineGecko, internal.OSLinux},
+	{internal.BrowserIceweasel, internal.BrowserFirefox, internal.EngineGecko, internal.OSLinux},
+	{internal.BrowserEpiphany, internal.BrowserSafari, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSLinux},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.BrowserKonqueror, internal.EngineGecko, internal.EngineWebKit, internal.OSLinux},
+	{internal.BrowserMidori, internal.BrowserFirefox, internal.EngineGecko, internal.OSLinux},
+	{internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.BrowserQutebrowser, internal.EngineGecko, internal.EngineWebKit, internal.OSLinux},
+	{internal.BrowserLynx},
+	{internal.BrowserW3m},
+	{internal.OSLinux, internal.BrowserLinks},
+
+	// Edge Legacy on Xbox
+	{internal.BrowserEdgeLegacy, internal.BrowserEdge, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.DeviceConsole, internal.OSWindows},
+
+	// Desktop Chromium forks
+	{internal.Browser360, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSWindows},
+	{internal.Browser360, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSWindows},
+	{internal.BrowserMaxthon, internal.BrowserSafari, internal.BrowserChrome, internal.EngineBlink, internal.EngineGecko, internal.EngineWebKit, internal.OSWindows},
+
+	// Watches and TVs
+	{internal.BrowserSafari, internal.DeviceMobile, internal.BrowserChrome, internal.EngineBlink, internal.BrowserSamsung, internal.EngineGecko, internal.EngineWebKit, internal.OSTizen, internal.OSLinux},
+	{internal.EngineGecko, internal.BrowserSafari, internal.EngineWebKit, internal.OSTVOS, internal.DeviceTV},
+	{internal.BrowserSafari, internal.TokenVersion, internal.EngineGecko, internal.EngineWebKit, internal.OSTVOS, internal.DeviceTV},
 }
 
 func TestMatchTokenIndexes(t *testing.T) {
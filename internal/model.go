@@ -0,0 +1,256 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/medama-io/go-useragent/agents"
+)
+
+// vendorLabels are brand names that prefix the model code in some user
+// agents, e.g. "SAMSUNG SM-G930F". They are stripped from the model.
+var vendorLabels = []struct {
+	label  string
+	vendor agents.Vendor
+}{
+	{"SAMSUNG", agents.VendorSamsung},
+	{"Samsung", agents.VendorSamsung},
+	{"HUAWEI", agents.VendorHuawei},
+	{"Huawei", agents.VendorHuawei},
+	{"HONOR", agents.VendorHonor},
+	{"Xiaomi", agents.VendorXiaomi},
+	{"XiaoMi", agents.VendorXiaomi},
+	{"OPPO", agents.VendorOppo},
+	{"vivo", agents.VendorVivo},
+	{"realme", agents.VendorRealme},
+	{"Realme", agents.VendorRealme},
+	{"OnePlus", agents.VendorOnePlus},
+	{"motorola", agents.VendorMotorola},
+	{"Motorola", agents.VendorMotorola},
+	{"Lenovo", agents.VendorLenovo},
+	{"LENOVO", agents.VendorLenovo},
+	{"Nokia", agents.VendorNokia},
+	{"ASUS", agents.VendorAsus},
+	{"Asus", agents.VendorAsus},
+	{"ZTE", agents.VendorZTE},
+	{"Infinix", agents.VendorInfinix},
+	{"TECNO", agents.VendorTecno},
+}
+
+// vendorPrefixes are the model code prefixes used by each vendor. These are
+// matched after any vendor label has been stripped from the model.
+var vendorPrefixes = []struct {
+	prefix string
+	vendor agents.Vendor
+}{
+	// Samsung
+	{"SM-", agents.VendorSamsung},
+	{"GT-", agents.VendorSamsung},
+	{"SGH-", agents.VendorSamsung},
+	{"SCH-", agents.VendorSamsung},
+	{"SHV-", agents.VendorSamsung},
+	{"SPH-", agents.VendorSamsung},
+	{"Galaxy", agents.VendorSamsung},
+
+	// Google
+	{"Pixel", agents.VendorGoogle},
+	{"Nexus", agents.VendorGoogle},
+
+	// Xiaomi
+	{"Redmi", agents.VendorXiaomi},
+	{"POCO", agents.VendorXiaomi},
+	{"Mi ", agents.VendorXiaomi},
+	{"MI ", agents.VendorXiaomi},
+
+	// Realme
+	{"RMX", agents.VendorRealme},
+
+	// OPPO
+	{"CPH", agents.VendorOppo},
+
+	// OnePlus
+	{"ONEPLUS", agents.VendorOnePlus},
+	{"A0001", agents.VendorOnePlus},
+
+	// Motorola
+	{"moto", agents.VendorMotorola},
+	{"Moto", agents.VendorMotorola},
+	{"XT", agents.VendorMotorola},
+
+	// Huawei
+	{"ELE-", agents.VendorHuawei},
+	{"VOG-", agents.VendorHuawei},
+	{"ANE-", agents.VendorHuawei},
+	{"MAR-", agents.VendorHuawei},
+	{"CLT-", agents.VendorHuawei},
+	{"DLI-", agents.VendorHuawei},
+	{"ELS-", agents.VendorHuawei},
+
+	// LG
+	{"LG", agents.VendorLG},
+	{"LM-", agents.VendorLG},
+
+	// Sony
+	{"SO-", agents.VendorSony},
+	{"Xperia", agents.VendorSony},
+
+	// Nokia
+	{"TA-", agents.VendorNokia},
+	{"Lumia", agents.VendorNokia},
+
+	// Others
+	{"HTC", agents.VendorHTC},
+	{"IdeaTab", agents.VendorLenovo},
+	{"ASUS_", agents.VendorAsus},
+	{"ALCATEL", agents.VendorAlcatel},
+	{"Micromax", agents.VendorMicromax},
+	{"KF", agents.VendorAmazon},
+	{"AFT", agents.VendorAmazon},
+	{"Kindle", agents.VendorAmazon},
+}
+
+// modelDevices are the model code prefixes of Android devices that send the
+// same user agent as phones. These are matched after any vendor label has been
+// stripped from the model.
+var modelDevices = []struct {
+	prefix string
+	device Match
+}{
+	{"SM-R", DeviceWearable}, // Samsung Galaxy Watch, e.g. "SM-R910".
+	{"KF", DeviceTablet},     // Amazon Fire, e.g. "KFTT".
+	{"Kindle Fire", DeviceTablet},
+	{"Quest", DeviceXR}, // Meta Quest in Android mode, e.g. "Quest 3".
+}
+
+// ParseAndroidModel returns the device model from the part of an Android
+// user agent comment that follows the Android token. For example, the
+// comment " 6.0.1; SAMSUNG SM-G930F Build/MMB29K" returns "SAMSUNG SM-G930F".
+//
+// The returned model is a substring of the comment to avoid allocations.
+func ParseAndroidModel(comment string) string {
+	var model string
+
+	// The first segment is always the Android version.
+	first := true
+	for comment != "" {
+		segment := comment
+		comment = ""
+		if i := strings.IndexByte(segment, ';'); i >= 0 {
+			comment = segment[i+1:]
+			segment = segment[:i]
+		}
+
+		if first {
+			first = false
+			continue
+		}
+
+		// The build ID always directly follows the model, so we can stop here.
+		if i := strings.Index(segment, "Build"); i >= 0 {
+			if segment = strings.TrimSpace(segment[:i]); segment != "" {
+				return segment
+			}
+			continue
+		}
+
+		segment = strings.TrimSpace(segment)
+		if isModel(segment) {
+			model = segment
+		}
+	}
+
+	return model
+}
+
+// IsAndroidWebView reports whether an Android user agent comment contains the
+// "wv" segment added by Android System WebView, e.g. "13; Pixel 7 Build/TQ3A; wv".
+func IsAndroidWebView(comment string) bool {
+	return hasSegment(comment, "wv")
+}
+
+// IsHarmonyOS reports whether an Android user agent comment contains the
+// "HarmonyOS" segment, e.g. "10; HarmonyOS; ELS-AN00; HMSCore 6.1.0.314".
+func IsHarmonyOS(comment string) bool {
+	return hasSegment(comment, "HarmonyOS")
+}
+
+// hasSegment reports whether a user agent comment contains the given
+// semicolon separated segment.
+func hasSegment(comment, value string) bool {
+	for comment != "" {
+		segment := comment
+		comment = ""
+		if i := strings.IndexByte(segment, ';'); i >= 0 {
+			comment = segment[i+1:]
+			segment = segment[:i]
+		}
+
+		if strings.TrimSpace(segment) == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isModel reports whether a user agent comment segment could be a model.
+func isModel(segment string) bool {
+	switch segment {
+	// "K" is used by the reduced user agent instead of the model.
+	case "", "K", "U", "wv", "Mobile", "Tablet", "TV", "Linux", "Java", "sdk", "gzip", "HarmonyOS":
+		return false
+	}
+
+	// Other products, e.g. "rv:123.0", "Opera Mini/7.6" or "HMSCore 6.1.0.314".
+	if strings.HasPrefix(segment, "rv:") || strings.HasPrefix(segment, "HMSCore") || strings.IndexByte(segment, '/') >= 0 {
+		return false
+	}
+
+	// Language codes, e.g. "en", "en-us" or "zh_CN".
+	if len(segment) == 2 && IsLetter(rune(segment[0])) && IsLetter(rune(segment[1])) {
+		return false
+	}
+	if len(segment) == 5 && (segment[2] == '-' || segment[2] == '_') &&
+		IsLetter(rune(segment[0])) && IsLetter(rune(segment[1])) &&
+		IsLetter(rune(segment[3])) && IsLetter(rune(segment[4])) {
+		return false
+	}
+
+	return true
+}
+
+// MatchModelDevice returns the device type of an Android model that sends the
+// same user agent as a phone, e.g. "SM-R910" or "Pixel Watch 2" returns
+// DeviceWearable. If the model is not known, it returns false.
+func MatchModelDevice(model string) (Match, bool) {
+	for _, m := range modelDevices {
+		if strings.HasPrefix(model, m.prefix) {
+			return m.device, true
+		}
+	}
+
+	if strings.Contains(model, "Watch") {
+		return DeviceWearable, true
+	}
+
+	return Unknown, false
+}
+
+// MatchVendor returns the vendor of a device model and the model with any
+// vendor label stripped, e.g. "SAMSUNG SM-G930F" returns Samsung and
+// "SM-G930F". If the vendor is unknown, the model is returned unchanged.
+func MatchVendor(model string) (agents.Vendor, string) {
+	for _, v := range vendorLabels {
+		if len(model) > len(v.label) && strings.HasPrefix(model, v.label) &&
+			(model[len(v.label)] == ' ' || model[len(v.label)] == '-' || model[len(v.label)] == '_') {
+			return v.vendor, model[len(v.label)+1:]
+		}
+	}
+
+	for _, v := range vendorPrefixes {
+		if strings.HasPrefix(model, v.prefix) {
+			return v.vendor, model
+		}
+	}
+
+	return "", model
+}
@@ -0,0 +1,97 @@
+package internal
+
+// VersionSource is where a version rule reads the version from.
+type VersionSource uint8
+
+const (
+	// SourceVersion reads the parsed browser version.
+	SourceVersion VersionSource = iota
+	// SourceEngine reads the parsed engine version.
+	SourceEngine
+	// SourceToken reads the version following the rule token in the user agent.
+	SourceToken
+)
+
+// VersionCondition is when a version rule applies to a parsed user agent.
+type VersionCondition uint8
+
+const (
+	// WhenAlways applies the rule to every user agent of the browser.
+	WhenAlways VersionCondition = iota
+	// WhenMissing applies the rule if no browser version was found.
+	WhenMissing
+	// WhenFromEngine applies the rule if the browser version is missing or was
+	// taken from the engine token, e.g. "Trident/7.0" without "MSIE".
+	WhenFromEngine
+	// WhenFrozen applies the rule if the browser version is the rule's frozen
+	// version, e.g. "9.80" for Opera.
+	WhenFrozen
+)
+
+// VersionRule replaces a legacy or frozen browser version with the product
+// version. Rules are applied in order after parsing.
+type VersionRule struct {
+	Browser Match
+	When    VersionCondition
+	Source  VersionSource
+	// Token is the user agent token preceding the version for SourceToken.
+	Token string
+	// Frozen is the browser version matched by WhenFrozen.
+	Frozen string
+	// Versions maps the major version read from the source to the product
+	// version. If nil, the version read from the source is used as is.
+	Versions map[int]string
+	// KeepBuild appends the components after the major version of the source
+	// to the mapped version, e.g. "18.17763" becomes "44.17763".
+	KeepBuild bool
+}
+
+// VersionRules are the version normalisation rules. New fixes for browsers
+// reporting a version other than their product version belong here.
+var VersionRules = []VersionRule{
+	// IE 11 dropped the MSIE token, so the version is taken from Trident.
+	{
+		Browser:  BrowserIE,
+		When:     WhenFromEngine,
+		Source:   SourceEngine,
+		Versions: map[int]string{4: "8.0", 5: "9.0", 6: "10.0", 7: "11.0", 8: "11.0"},
+	},
+	// IE Mobile 11 on Windows Phone only reports its version after "rv:".
+	{Browser: BrowserIE, When: WhenMissing, Source: SourceToken, Token: "rv:"},
+	// Edge Legacy reports the EdgeHTML version rather than the app version.
+	{
+		Browser:   BrowserEdgeLegacy,
+		When:      WhenAlways,
+		Source:    SourceVersion,
+		Versions:  map[int]string{12: "20", 13: "25", 14: "38", 15: "40", 16: "41", 17: "42", 18: "44"},
+		KeepBuild: true,
+	},
+	// Safari 1 and 2 predate the Version token, so the WebKit build is mapped.
+	{
+		Browser: BrowserSafari,
+		When:    WhenMissing,
+		Source:  SourceToken,
+		Token:   "Safari/",
+		Versions: map[int]string{
+			85: "1.0", 100: "1.1", 125: "1.2", 312: "1.3",
+			412: "2.0", 416: "2.0.2", 417: "2.0.3", 419: "2.0.4",
+		},
+	},
+	// Opera 10 and later froze the Opera token at 9.80 for compatibility.
+	{Browser: BrowserOpera, When: WhenFrozen, Frozen: "9.80", Source: SourceToken, Token: "Version/"},
+	// The Sogou mobile token contains the Mobile token, so its version is
+	// skipped along with the rest of the mobile identifier.
+	{Browser: BrowserSogou, When: WhenMissing, Source: SourceToken, Token: "SogouMobileBrowser/"},
+	// Waterfox G releases prefix the version, e.g. "Waterfox/G6.0.5".
+	{Browser: BrowserWaterfox, When: WhenMissing, Source: SourceToken, Token: "Waterfox/G"},
+	// Links puts the version in a comment, e.g. "Links (2.29; Linux)".
+	{Browser: BrowserLinks, When: WhenMissing, Source: SourceToken, Token: "Links ("},
+	// The desktop 360 browsers append "QIHU 360SE" or "QIHU 360EE", so the
+	// product name is captured as the version. Newer releases also include the
+	// QHBrowser token with the actual version, otherwise there is none.
+	{Browser: Browser360, When: WhenFrozen, Frozen: "360", Source: SourceToken, Token: "QHBrowser/"},
+	{Browser: Browser360, When: WhenFrozen, Frozen: "360", Source: SourceVersion, Versions: map[int]string{360: ""}},
+	// The w3m token is found outside of the trie, so its version is not
+	// captured while parsing.
+	{Browser: BrowserW3m, When: WhenMissing, Source: SourceToken, Token: "w3m/"},
+}
@@ -0,0 +1,124 @@
+package internal
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+)
+
+//go:generate go run ../scripts/releases/main.go releases.csv
+
+// ReleaseKind is the type of product a release belongs to.
+type ReleaseKind string
+
+const (
+	ReleaseBrowser ReleaseKind = "browser"
+	ReleaseOS      ReleaseKind = "os"
+)
+
+// ReleaseDateLayout is the date format used in the release table.
+const ReleaseDateLayout = time.DateOnly
+
+// releaseSupported marks a release that is still supported without an
+// announced end of life, e.g. Firefox ESR or Windows 10 and 11.
+const releaseSupported = "supported"
+
+// Release is a single major release of a browser or operating system.
+type Release struct {
+	Kind ReleaseKind
+	// Name is the browser or operating system name as returned by the parser,
+	// e.g. "Chrome" or "Windows".
+	Name string
+	// Version is the version number the release starts at, e.g. "120" or "10.15".
+	Version  string
+	Released time.Time
+	// EOL is the date the release stopped receiving security updates. If zero,
+	// support ends once a newer release is available, unless LongTerm is set.
+	EOL time.Time
+	// LongTerm is set for releases that are supported alongside newer releases
+	// without an announced end of life.
+	LongTerm bool
+}
+
+//go:embed releases.csv
+var ReleasesFile string
+
+// ParseReleases parses a release table in CSV format with the header
+// "kind,name,version,released,eol". The eol column is either empty, a date, or
+// "supported" for long term releases.
+func ParseReleases(data string) ([]Release, error) {
+	r := csv.NewReader(strings.NewReader(data))
+	r.FieldsPerRecord = 5
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []Release
+	for i, record := range records {
+		// Skip the header.
+		if i == 0 {
+			continue
+		}
+
+		release := Release{
+			Kind:    ReleaseKind(record[0]),
+			Name:    record[1],
+			Version: record[2],
+		}
+
+		switch release.Kind {
+		case ReleaseBrowser, ReleaseOS:
+		default:
+			return nil, fmt.Errorf("line %d: unknown kind %q", i+1, record[0])
+		}
+
+		if release.Name == "" || release.Version == "" || strings.Trim(release.Version, "0123456789.") != "" {
+			return nil, fmt.Errorf("line %d: invalid name or version", i+1)
+		}
+
+		release.Released, err = time.Parse(ReleaseDateLayout, record[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid release date: %w", i+1, err)
+		}
+
+		switch record[4] {
+		case "":
+		case releaseSupported:
+			release.LongTerm = true
+		default:
+			release.EOL, err = time.Parse(ReleaseDateLayout, record[4])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid end of life date: %w", i+1, err)
+			}
+		}
+
+		releases = append(releases, release)
+	}
+
+	return releases, nil
+}
+
+// FormatReleases formats a release table in the format read by ParseReleases.
+func FormatReleases(releases []Release) string {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	_ = w.Write([]string{"kind", "name", "version", "released", "eol"})
+
+	for _, r := range releases {
+		eol := ""
+		switch {
+		case r.LongTerm:
+			eol = releaseSupported
+		case !r.EOL.IsZero():
+			eol = r.EOL.Format(ReleaseDateLayout)
+		}
+		_ = w.Write([]string{string(r.Kind), r.Name, r.Version, r.Released.Format(ReleaseDateLayout), eol})
+	}
+
+	w.Flush()
+	return b.String()
+}
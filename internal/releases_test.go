@@ -0,0 +1,38 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medama-io/go-useragent/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReleases(t *testing.T) {
+	const header = "kind,name,version,released,eol\n"
+
+	_, err := internal.ParseReleases(header + "app,Chrome,120,2023-12-05,\n")
+	assert.Error(t, err)
+
+	_, err = internal.ParseReleases(header + "browser,Chrome,latest,2023-12-05,\n")
+	assert.Error(t, err)
+
+	_, err = internal.ParseReleases(header + "browser,Chrome,120,2023-12-05,never\n")
+	assert.Error(t, err)
+
+	data := header +
+		"browser,Chrome,120,2023-12-05,\n" +
+		"browser,IE,11,2013-10-17,2022-06-15\n" +
+		"os,Windows,10.0,2015-07-29,supported\n"
+	list, err := internal.ParseReleases(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []internal.Release{
+		{Kind: internal.ReleaseBrowser, Name: "Chrome", Version: "120", Released: time.Date(2023, 12, 5, 0, 0, 0, 0, time.UTC)},
+		{Kind: internal.ReleaseBrowser, Name: "IE", Version: "11", Released: time.Date(2013, 10, 17, 0, 0, 0, 0, time.UTC), EOL: time.Date(2022, 6, 15, 0, 0, 0, 0, time.UTC)},
+		{Kind: internal.ReleaseOS, Name: "Windows", Version: "10.0", Released: time.Date(2015, 7, 29, 0, 0, 0, 0, time.UTC), LongTerm: true},
+	}, list)
+	assert.Equal(t, data, internal.FormatReleases(list))
+
+	_, err = internal.ParseReleases(internal.ReleasesFile)
+	assert.NoError(t, err)
+}
@@ -1,5 +1,7 @@
 package internal
 
+import "strings"
+
 // IsDigit reports whether the rune is a decimal digit.
 //
 // This is an optimised version of unicode.IsDigit without
@@ -15,3 +17,19 @@ func IsDigit(r rune) bool {
 func IsLetter(r rune) bool {
 	return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z'
 }
+
+// ParseTokenList parses a newline separated list of tokens, ignoring blank
+// lines and "#" comments.
+func ParseTokenList(list string) []string {
+	var tokens []string
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		tokens = append(tokens, line)
+	}
+
+	return tokens
+}
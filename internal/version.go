@@ -1,5 +1,11 @@
 package internal
 
+import (
+	"strings"
+
+	str "github.com/boyter/go-string"
+)
+
 // ReplaceIndexes replaces the runes at the given indexes with empty strings.
 func ReplaceIndexes(ua string, indexes []int) string {
 	// Remove the version numbers from the user agent string.
@@ -60,76 +66,71 @@ func RemoveVersions(ua string) string {
 // RemoveMobileIdentifiers removes the device identifiers from the user agent string.
 // This specifically removes any strings that follow the Mobile tokens.
 // For example, "Mobile/14F89" should be "Mobile".
+//
+// Identifiers are removed after every occurrence of the token, as the parser
+// skips them wherever the token is found.
 func RemoveMobileIdentifiers(ua string) string {
-	tokens := MatchTokenIndexes(ua)
-
-	// Find mobile token.
-	for _, token := range tokens {
-		var skipUntilWhitespace bool
-		var indexesToReplace []int
-		if token.Match == DeviceMobile {
-			// Iterate over the user agent string and remove all characters
-			// after the mobile token until we encounter whitespace.
-			for i, r := range ua {
-				if skipUntilWhitespace {
-					if r == ' ' {
-						skipUntilWhitespace = false
-					} else {
-						indexesToReplace = append(indexesToReplace, i)
-						continue
-					}
-				}
-
-				if i == token.EndIndex-1 {
-					skipUntilWhitespace = true
-				}
+	var indexesToReplace []int
+	for _, m := range matchMap[DeviceMobile] {
+		for _, index := range str.IndexAll(ua, m, -1) {
+			// Remove all characters after the mobile token until we encounter whitespace.
+			for i := index[1]; i < len(ua) && ua[i] != ' '; i++ {
+				indexesToReplace = append(indexesToReplace, i)
 			}
-
-			ua = ReplaceIndexes(ua, indexesToReplace)
-			return ua
 		}
 	}
 
-	return ua
+	return ReplaceIndexes(ua, indexesToReplace)
 }
 
+// androidPlatformToken is the Android token in the platform comment of a user
+// agent, followed by its version.
+const androidPlatformToken = "; Android "
+
 // RemoveAndroidIdentifiers removes the device identifiers from the user agent string.
 // This specifically removes any strings that follow the Android tokens.
 func RemoveAndroidIdentifiers(ua string) string {
-	tokens := MatchTokenIndexes(ua)
+	// The platform comment, e.g. "(Linux; Android 12; SM-G973F)", is where the
+	// parser skips the device identifiers, but in-app browsers such as Snapchat
+	// may repeat the Android token in their own comment.
+	if i := strings.Index(ua, androidPlatformToken); i != -1 {
+		ua = removeUntilClosingParenthesis(ua, i+len(androidPlatformToken)-1)
+	}
 
 	// Find mobile token.
-	for _, token := range tokens {
-		var skipUntilClosingParenthesis int
-		var indexesToReplace []int
-
+	for _, token := range MatchTokenIndexes(ua) {
 		if token.Match == OSAndroid {
-			// Iterate over the user agent string and remove all characters
-			// after the Android token until we encounter a closing parenthesis
-			// to remove device identifiers.
-			for i, r := range ua {
-				if skipUntilClosingParenthesis > 0 {
-					if r == '(' {
-						skipUntilClosingParenthesis++
-					}
-
-					if r == ')' {
-						skipUntilClosingParenthesis--
-					} else {
-						indexesToReplace = append(indexesToReplace, i)
-						continue
-					}
-				}
-
-				if i == token.EndIndex-1 {
-					skipUntilClosingParenthesis++
-				}
+			return removeUntilClosingParenthesis(ua, token.EndIndex)
+		}
+	}
+
+	return ua
+}
+
+// removeUntilClosingParenthesis removes all characters after the end index
+// until we encounter a closing parenthesis.
+func removeUntilClosingParenthesis(ua string, end int) string {
+	var skipUntilClosingParenthesis int
+	var indexesToReplace []int
+
+	for i, r := range ua {
+		if skipUntilClosingParenthesis > 0 {
+			if r == '(' {
+				skipUntilClosingParenthesis++
 			}
 
-			ua = ReplaceIndexes(ua, indexesToReplace)
-			return ua
+			if r == ')' {
+				skipUntilClosingParenthesis--
+			} else {
+				indexesToReplace = append(indexesToReplace, i)
+				continue
+			}
+		}
+
+		if i == end-1 {
+			skipUntilClosingParenthesis++
 		}
 	}
 
-	return ua
+	return ReplaceIndexes(ua, indexesToReplace)
 }
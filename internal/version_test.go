@@ -72,6 +72,84 @@ var versionResults = []string{
 	"MozillaXLinuxxAppleWebKitKHTMLlikeGeckoSamsungBrowserChromeSafari",
 
 	"MozillaXOpenBSDamdrvGeckoFirefox",
+
+	"MozillaAppleWebKitKHTMLlikeGeckocompatibleClaudeBotclaudebotanthropiccom",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoMobileSafaricompatibleBytespiderspiderfeedbackbytedancecom",
+
+	// HTTP Libraries
+	"curl",
+	"pythonrequests",
+	"Gohttpclient",
+	"okhttp",
+	"PostmanRuntime",
+	"Java",
+
+	// gRPC Clients
+	"grpcgo",
+	"grpcjavanetty",
+	"grpcnodejs",
+	"grpcwebjavascript",
+
+	// Chromium forks and regional browsers
+	"MozillaLinuxUAndroidAppleWebKitKHTMLlikeGeckoVersionChromeUCBrowserMobileSafari",
+	"MozillaLinuxUAndroidAppleWebKitKHTMLlikeGeckoVersionChromeMQQBrowserMobileSafari",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoChromeHuaweiBrowserMobileSafari",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoChromeMobileSafariXiaoMiMiuiBrowser",
+	"MozillaWindowsNTWinxAppleWebKitKHTMLlikeGeckoChromeWhaleSafari",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoChromeMobileSafariDuckDuckGo",
+	"MozillaMacintoshIntelMacOSXAppleWebKitKHTMLlikeGeckoVersionSafariDdg",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoSilklikeChromeSafari",
+	"MozillaWindowsNTWinxAppleWebKitKHTMLlikeGeckococcocbrowserChromeSafari",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoVersionChromeMobileSafariSogouMobile",
+	"MozillaWindowsNTWinxAppleWebKitKHTMLlikeGeckoChromeSafariQIHUSEQHBrowser",
+	"MozillaiPhoneCPUiPhoneOSlikeMacOSXAppleWebKitKHTMLlikeGeckoVersionMXiOSMobileSafari",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoChromeMobileSafariOPX",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoChromeMobileSafariEdgA",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoVersionFocusChromeMobileSafari",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoVersionKlarChromeMobileSafari",
+
+	// Other operating systems
+	"MozillaXFreeBSDamdrvGeckoFirefox",
+	"MozillaXNetBSDamdrvGeckoFirefox",
+	"MozillaWindowsPhoneAndroidAppleWebKitKHTMLlikeGeckoChromeMobileSafariEdge",
+	"MozillacompatibleMSIEWindowsPhoneTridentIEMobileARMTouchNOKIALumia",
+	"MozillaMobileNokiaGrvGeckoFirefoxKAIOS",
+	"MozillaLinuxTizenSAMSUNGSMZHAppleWebKitKHTMLlikeGeckoSamsungBrowserMobileSafari",
+	"MozillaWebSLinuxSmartTVAppleWebKitKHTMLlikeGeckoChromeSafariWebAppManager",
+	"MozillaLinuxAndroidAppleWebKitKHTMLlikeGeckoChromeHuaweiBrowserMobileSafari",
+	"MozillaPhoneOpenHarmonyAppleWebKitKHTMLlikeGeckoChromeSafariArkWebMobileHuaweiBrowser",
+	"MozillaXFuchsiaAppleWebKitKHTMLlikeGeckoChromeSafari",
+	"MozillaLinuxSailfishMobilervGeckoFirefoxSailfishBrowser",
+	"MozillaAppleTVCPUtvOSlikeMacOSXAppleWebKitKHTMLlikeGecko",
+	"MozillaAppleWatchCPUwatchOSlikeMacOSXAppleWebKitKHTMLlikeGecko",
+
+	// Gecko forks and niche desktop browsers
+	"MozillaWindowsNTWinxrvGeckoFirefoxWaterfoxG",
+	"MozillaWindowsNTWinxrvGeckoGoannaFirefoxPaleMoon",
+	"MozillaWindowsNTWOWrvGeckoGoannaPaleMoonFirefox",
+	"MozillaWindowsNTWinxrvGeckoFirefoxSeaMonkey",
+	"MozillaXLinuxxrvGeckoFirefoxLibreWolf",
+	"MozillaXLinuxxrvGeckoFirefoxIceweasel",
+	"MozillaXLinuxxAppleWebKitKHTMLlikeGeckoVersionSafariEpiphany",
+	"MozillaXLinuxxAppleWebKitKHTMLlikeGeckoKonquerorChromeSafari",
+	"MozillaXLinuxxrvGeckoFirefoxMidori",
+	"MozillaXLinuxxAppleWebKitKHTMLlikeGeckoqutebrowserChromeSafari",
+	"LynxdevlibwwwFMSSLMMGNUTLS",
+	"wmgit",
+	"LinksLinuxxGNUCtext",
+
+	// Edge Legacy on Xbox
+	"MozillaWindowsNTWinxXboxXboxOneAppleWebKitKHTMLlikeGeckoChromeSafariEdge",
+
+	// Desktop Chromium forks
+	"MozillaWindowsNTWOWAppleWebKitKHTMLlikeGeckoChromeSafariQIHUSE",
+	"MozillaWindowsNTWinxAppleWebKitKHTMLlikeGeckoChromeSafariQIHUEE",
+	"MozillaWindowsNTWinxAppleWebKitKHTMLlikeGeckoChromeSafariMaxthon",
+
+	// Watches and TVs
+	"MozillaLinuxTizenSAMSUNGSMRAppleWebKitKHTMLlikeGeckoSamsungBrowserChromeMobileSafari",
+	"MozillaAppleTVCPUOSlikeMacOSXAppleWebKitKHTMLlikeGecko",
+	"MozillaAppleTVCPUOSlikeMacOSXAppleWebKitKHTMLlikeGeckoVersionSafari",
 }
 
 func TestCleanVersions(t *testing.T) {
@@ -0,0 +1,118 @@
+package useragent
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogValue implements slog.LogValuer, logging the parsed user agent as a group
+// with the browser, version, os, os_version, device and bot attributes.
+func (ua UserAgent) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("browser", string(ua.Browser())),
+		slog.String("version", ua.BrowserVersion()),
+		slog.String("os", string(ua.OS())),
+		slog.String("os_version", string(ua.osVersion[:ua.osVersionIndex])),
+		slog.String("device", string(ua.Device())),
+		slog.Bool("bot", ua.IsBot()),
+	)
+}
+
+// LogHandlerOptions are the options for a LogHandler. A zero value uses the
+// defaults.
+type LogHandlerOptions struct {
+	// Key is the attribute holding the raw user agent. Defaults to
+	// "user_agent".
+	Key string
+	// ParsedKey is the attribute the parsed user agent is added as. Defaults
+	// to Key with a "_parsed" suffix.
+	ParsedKey string
+	// Parser parses the raw user agent. Defaults to NewParser.
+	Parser *Parser
+}
+
+// LogHandler is a slog.Handler that enriches records carrying a raw user agent
+// attribute with the parsed user agent, before passing them to the wrapped
+// handler. The raw attribute is kept as is.
+type LogHandler struct {
+	next      slog.Handler
+	key       string
+	parsedKey string
+	parser    *Parser
+}
+
+// NewLogHandler returns a LogHandler wrapping next. If opts is nil, the
+// default options are used.
+func NewLogHandler(next slog.Handler, opts *LogHandlerOptions) *LogHandler {
+	h := &LogHandler{next: next, key: "user_agent"}
+	if opts != nil {
+		if opts.Key != "" {
+			h.key = opts.Key
+		}
+		h.parsedKey = opts.ParsedKey
+		h.parser = opts.Parser
+	}
+
+	if h.parsedKey == "" {
+		h.parsedKey = h.key + "_parsed"
+	}
+	if h.parser == nil {
+		h.parser = NewParser()
+	}
+
+	return h
+}
+
+// Enabled reports whether the wrapped handler handles records at the level.
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle adds the parsed user agent to the record if it has a raw user agent
+// attribute, then passes it to the wrapped handler.
+func (h *LogHandler) Handle(ctx context.Context, r slog.Record) error {
+	var parsed slog.Attr
+	r.Attrs(func(a slog.Attr) bool {
+		var ok bool
+		parsed, ok = h.parse(a)
+		return !ok
+	})
+
+	if parsed.Key != "" {
+		r = r.Clone()
+		r.AddAttrs(parsed)
+	}
+
+	return h.next.Handle(ctx, r)
+}
+
+// WithAttrs returns a handler with the attributes added, including the parsed
+// user agent if one of them is the raw user agent, e.g. from Logger.With.
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	for _, a := range attrs {
+		if parsed, ok := h.parse(a); ok {
+			attrs = append(attrs[:len(attrs):len(attrs)], parsed)
+			break
+		}
+	}
+
+	clone := *h
+	clone.next = h.next.WithAttrs(attrs)
+	return &clone
+}
+
+// WithGroup returns a handler with the group added to the wrapped handler.
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	clone := *h
+	clone.next = h.next.WithGroup(name)
+	return &clone
+}
+
+// parse returns the parsed user agent attribute if a is the raw user agent.
+func (h *LogHandler) parse(a slog.Attr) (slog.Attr, bool) {
+	if a.Key != h.key || a.Value.Kind() != slog.KindString {
+		return slog.Attr{}, false
+	}
+
+	return slog.Any(h.parsedKey, h.parser.Parse(a.Value.String())), true
+}
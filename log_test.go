@@ -0,0 +1,68 @@
+package useragent_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	ua "github.com/medama-io/go-useragent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const logUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("request", "agent", ua.NewParser().Parse(logUserAgent))
+	assert.Equal(t, "level=INFO msg=request agent.browser=Chrome agent.version=118.0.0.0 agent.os=Windows agent.os_version=10.0 agent.device=Desktop agent.bot=false\n", buf.String())
+}
+
+func TestLogHandler(t *testing.T) {
+	decode := func(buf *bytes.Buffer) map[string]any {
+		var m map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &m))
+		buf.Reset()
+		return m
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(ua.NewLogHandler(slog.NewJSONHandler(&buf, nil), nil))
+
+	logger.Info("request", "user_agent", logUserAgent)
+	record := decode(&buf)
+	assert.Equal(t, logUserAgent, record["user_agent"])
+	assert.Equal(t, map[string]any{
+		"browser":    "Chrome",
+		"version":    "118.0.0.0",
+		"os":         "Windows",
+		"os_version": "10.0",
+		"device":     "Desktop",
+		"bot":        false,
+	}, record["user_agent_parsed"])
+
+	// Records without a user agent are passed through unchanged.
+	logger.Info("request", "path", "/")
+	record = decode(&buf)
+	assert.NotContains(t, record, "user_agent_parsed")
+
+	// Attributes added with Logger.With are also enriched.
+	logger = slog.New(ua.NewLogHandler(slog.NewJSONHandler(&buf, nil), &ua.LogHandlerOptions{
+		Key:       "ua",
+		ParsedKey: "client",
+	}))
+	logger.With("ua", "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)").Info("request")
+	record = decode(&buf)
+	require.Contains(t, record, "client")
+	assert.Equal(t, true, record["client"].(map[string]any)["bot"])
+}
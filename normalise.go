@@ -0,0 +1,86 @@
+package useragent
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/medama-io/go-useragent/internal"
+)
+
+// normaliseVersion replaces legacy and frozen browser versions with the product
+// version, using the rules in internal.VersionRules.
+func (ua *UserAgent) normaliseVersion(key string) {
+	for _, rule := range internal.VersionRules {
+		if rule.Browser != ua.browser || !ua.versionRuleApplies(rule) {
+			continue
+		}
+
+		// Copy the source version so the browser version can be overwritten.
+		var source [32]rune
+		var n int
+		switch rule.Source {
+		case internal.SourceVersion:
+			n = copy(source[:], ua.version[:ua.versionIndex])
+		case internal.SourceEngine:
+			n = copy(source[:], ua.engineVersion[:ua.engineVersionIndex])
+		case internal.SourceToken:
+			_, after, ok := strings.Cut(key, rule.Token)
+			if !ok {
+				continue
+			}
+			for _, r := range after {
+				if !internal.IsDigit(r) && r != '.' || n == len(source) {
+					break
+				}
+				source[n] = r
+				n++
+			}
+		}
+
+		if n == 0 {
+			continue
+		}
+
+		if rule.Versions == nil {
+			ua.version = source
+			ua.versionIndex = n
+			continue
+		}
+
+		mapped, ok := rule.Versions[versionFromRunes(source[:n]).Major]
+		if !ok {
+			continue
+		}
+
+		ua.setVersion(mapped)
+		if rule.KeepBuild {
+			if i := slices.Index(source[:n], '.'); i != -1 {
+				ua.versionIndex += copy(ua.version[ua.versionIndex:], source[i:n])
+			}
+		}
+	}
+}
+
+// versionRuleApplies returns true if the rule condition matches the parsed
+// browser version.
+func (ua *UserAgent) versionRuleApplies(rule internal.VersionRule) bool {
+	version := ua.version[:ua.versionIndex]
+
+	switch rule.When {
+	case internal.WhenMissing:
+		return len(version) == 0
+	case internal.WhenFromEngine:
+		return len(version) == 0 || slices.Equal(version, ua.engineVersion[:ua.engineVersionIndex])
+	case internal.WhenFrozen:
+		if len(version) != len(rule.Frozen) {
+			return false
+		}
+		for i, r := range rule.Frozen {
+			if version[i] != r {
+				return false
+			}
+		}
+	}
+
+	return true
+}
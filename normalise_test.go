@@ -0,0 +1,55 @@
+package useragent_test
+
+import (
+	"fmt"
+	"testing"
+
+	ua "github.com/medama-io/go-useragent"
+
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormaliseVersion(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent     string
+		Browser       agents.Browser
+		Version       string
+		EngineVersion string
+	}{
+		// Trident versions are mapped to IE unless MSIE reports a version.
+		{"Mozilla/5.0 (Windows NT 10.0; Trident/7.0; rv:11.0) like Gecko", agents.BrowserIE, "11.0", "7.0"},
+		{"Mozilla/5.0 (compatible;  ; Windows NT 6.1; Trident/4.0; SLCC2)", agents.BrowserIE, "8.0", "4.0"},
+		{"Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.1; WOW64; Trident/4.0; SLCC2)", agents.BrowserIE, "8.0", "4.0"},
+		{"Mozilla/4.0 (compatible; MSIE 7.0; Windows NT 6.1; Trident/4.0)", agents.BrowserIE, "7.0", "4.0"},
+		{"Mozilla/5.0 (Mobile; Windows Phone 8.1; Android 4.0; ARM; Trident/7.0; Touch; rv:11.0; IEMobile/11.0; NOKIA; Lumia 630) like iPhone OS 7_0_3 Mac OS X AppleWebKit/537 (KHTML, like Gecko) Mobile Safari/537", agents.BrowserIE, "11.0", ""},
+		// Edge Legacy reports the EdgeHTML version.
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/64.0.3282.140 Safari/537.36 Edge/18.17763", agents.BrowserEdgeLegacy, "44.17763", "18.17763"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91", agents.BrowserEdge, "120.0.2210.91", "120.0.0.0"},
+		// Safari before the Version token.
+		{"Mozilla/5.0 (Macintosh; U; Intel Mac OS X; en) AppleWebKit/419.3 (KHTML, like Gecko) Safari/419.3", agents.BrowserSafari, "2.0.4", "419.3"},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15", agents.BrowserSafari, "17.1", "605.1.15"},
+		// Opera froze its own token at 9.80.
+		{"Opera/9.80 (Windows NT 6.1; WOW64) Presto/2.12.388 Version/12.16", agents.BrowserOpera, "12.16", "2.12.388"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/106.0.0.0", agents.BrowserOpera, "106.0.0.0", "120.0.0.0"},
+		// Versions that don't directly follow the browser token.
+		{"Mozilla/5.0 (Linux; Android 10; MI 8 Build/QKQ1.190828.002; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/87.0.4280.101 Mobile Safari/537.36 SogouMobileBrowser/5.39.1", agents.BrowserSogou, "5.39.1", "87.0.4280.101"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:109.0) Gecko/20100101 Firefox/115.0 Waterfox/G6.0.5", agents.BrowserWaterfox, "6.0.5", ""},
+		{"Links (2.29; Linux 6.1.0-13-amd64 x86_64; GNU C 12.2; text)", agents.BrowserLinks, "2.29", ""},
+		{"w3m/0.5.3+git20230121", agents.BrowserW3m, "0.5.3", ""},
+		// The 360 product name is not a version.
+		{"Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/86.0.4240.198 Safari/537.36 QIHU 360SE", agents.Browser360, "", "86.0.4240.198"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/108.0.0.0 Safari/537.36 QIHU 360SE QHBrowser/13.5.1010.0", agents.Browser360, "13.5.1010.0", "108.0.0.0"},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.Browser, result.Browser(), "Browser\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.Version, result.BrowserVersion(), "Version\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.EngineVersion, result.EngineVersion(), "Engine Version\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
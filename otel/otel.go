@@ -0,0 +1,63 @@
+// Package otel converts parsed user agents into OpenTelemetry semantic
+// convention attributes, so tracing backends can group spans by client.
+//
+// It is a separate module so the parser does not depend on OpenTelemetry.
+package otel
+
+import (
+	"net/http"
+
+	"github.com/medama-io/go-useragent"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attributes returns the user_agent.* and device.* attributes of the parsed
+// user agent. Attributes without a value are omitted.
+//
+// The raw user_agent.original attribute is not included, as it is already set
+// by the HTTP instrumentation.
+func Attributes(ua useragent.UserAgent) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, 7)
+
+	if browser := ua.Browser(); browser != "" {
+		attrs = append(attrs, semconv.UserAgentName(string(browser)))
+	}
+	if version := ua.BrowserVersion(); version != "" {
+		attrs = append(attrs, semconv.UserAgentVersion(version))
+	}
+	if os := ua.OS(); os != "" {
+		attrs = append(attrs, semconv.UserAgentOSName(string(os)))
+	}
+	if version := ua.OSVersion(); !version.IsZero() {
+		attrs = append(attrs, semconv.UserAgentOSVersion(version.String()))
+	}
+	if ua.IsBot() {
+		attrs = append(attrs, semconv.UserAgentSyntheticTypeBot)
+	}
+	if model := ua.DeviceModel(); model != "" {
+		attrs = append(attrs, semconv.DeviceModelIdentifier(model))
+	}
+	if vendor := ua.DeviceVendor(); vendor != "" {
+		attrs = append(attrs, semconv.DeviceManufacturer(string(vendor)))
+	}
+
+	return attrs
+}
+
+// Middleware parses the user agent and Client Hints of each request, and adds
+// their attributes to the span in the request context. It must be wrapped by
+// the tracing middleware, e.g. otelhttp.NewHandler, so the span exists.
+func Middleware(next http.Handler) http.Handler {
+	parser := useragent.NewParser()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if span := trace.SpanFromContext(r.Context()); span.IsRecording() {
+			ua := parser.ParseWithHints(r.UserAgent(), useragent.HintsFromHeader(r.Header))
+			span.SetAttributes(Attributes(ua)...)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,59 @@
+package otel_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/medama-io/go-useragent"
+	uaotel "github.com/medama-io/go-useragent/otel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAttributes(t *testing.T) {
+	parser := useragent.NewParser()
+
+	attrs := uaotel.Attributes(parser.Parse("Mozilla/5.0 (Linux; Android 13; SM-S911B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36"))
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("user_agent.name", "Samsung Browser"),
+		attribute.String("user_agent.version", "23.0"),
+		attribute.String("user_agent.os.name", "Android"),
+		attribute.String("user_agent.os.version", "13.0"),
+		attribute.String("device.model.identifier", "SM-S911B"),
+		attribute.String("device.manufacturer", "Samsung"),
+	}, attrs)
+
+	attrs = uaotel.Attributes(parser.Parse("Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"))
+	assert.Contains(t, attrs, attribute.String("user_agent.synthetic.type", "bot"))
+
+	assert.Empty(t, uaotel.Attributes(useragent.UserAgent{}))
+}
+
+func TestMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	handler := uaotel.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36")
+	ctx, span := tracer.Start(req.Context(), "request")
+	handler.ServeHTTP(httptest.NewRecorder(), req.WithContext(ctx))
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("user_agent.name", "Chrome"),
+		attribute.String("user_agent.version", "118.0.0.0"),
+		attribute.String("user_agent.os.name", "Windows"),
+		attribute.String("user_agent.os.version", "10.0"),
+	}, spans[0].Attributes())
+
+	// Requests without a span are passed through.
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
@@ -0,0 +1,220 @@
+// Package policy evaluates a browser support policy against parsed user agents.
+//
+// Policies are written as browserslist-like queries, a comma separated list of
+// terms such as "Chrome >= 109, Safari >= 15.4, Firefox ESR, not IE". A user
+// agent is allowed if it matches any of the terms and none of the terms
+// prefixed with "not". A policy containing only "not" terms allows every other
+// browser.
+//
+// Queries are compiled once, so evaluating a policy does not allocate.
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/medama-io/go-useragent"
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/medama-io/go-useragent/internal"
+)
+
+type operator uint8
+
+const (
+	opAny operator = iota
+	opGreaterEqual
+	opGreater
+	opLessEqual
+	opLess
+)
+
+// operators are checked in order, so longer operators must come first.
+var operators = []struct {
+	token string
+	op    operator
+}{
+	{">=", opGreaterEqual},
+	{"<=", opLessEqual},
+	{">", opGreater},
+	{"<", opLess},
+}
+
+// rule is a single compiled term of a query.
+type rule struct {
+	browser agents.Browser
+	op      operator
+	version useragent.Version
+	// channel restricts the rule to a release channel, e.g. Firefox ESR.
+	channel agents.Channel
+}
+
+// Policy is a compiled browser support policy. It is safe for concurrent use.
+type Policy struct {
+	allow []rule
+	deny  []rule
+}
+
+// browserNames maps the lowercase browser names and common browserslist
+// aliases to their browser.
+var browserNames = func() map[string]agents.Browser {
+	names := map[string]agents.Browser{
+		"ff":       agents.BrowserFirefox,
+		"samsung":  agents.BrowserSamsung,
+		"explorer": agents.BrowserIE,
+	}
+
+	for m := internal.Match(1); m != internal.Unknown; m++ {
+		if m.GetMatchType() == internal.MatchBrowser {
+			browser := m.GetMatchBrowser()
+			names[strings.ToLower(string(browser))] = browser
+		}
+	}
+
+	return names
+}()
+
+// Compile parses a query into a Policy. Browser names are case insensitive and
+// match the names returned by UserAgent.Browser, e.g. "Samsung Browser".
+func Compile(query string) (*Policy, error) {
+	p := &Policy{}
+
+	for _, term := range strings.Split(query, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		deny := false
+		lower := strings.ToLower(term)
+		for _, prefix := range []string{"not ", "no "} {
+			if strings.HasPrefix(lower, prefix) {
+				deny = true
+				term = strings.TrimSpace(term[len(prefix):])
+				break
+			}
+		}
+
+		r, err := compileRule(term)
+		if err != nil {
+			return nil, fmt.Errorf("policy %q: %w", query, err)
+		}
+
+		if deny {
+			p.deny = append(p.deny, r)
+		} else {
+			p.allow = append(p.allow, r)
+		}
+	}
+
+	if len(p.allow) == 0 && len(p.deny) == 0 {
+		return nil, fmt.Errorf("policy %q: no terms", query)
+	}
+
+	return p, nil
+}
+
+// MustCompile is like Compile but panics if the query cannot be parsed. It is
+// intended for policies declared as package variables.
+func MustCompile(query string) *Policy {
+	p, err := Compile(query)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// compileRule parses a single term without its "not" prefix.
+func compileRule(term string) (rule, error) {
+	var r rule
+	name := term
+
+	for _, o := range operators {
+		if before, after, ok := strings.Cut(term, o.token); ok {
+			r.op = o.op
+			name = before
+
+			after = strings.TrimSpace(after)
+			r.version = useragent.ParseVersion(after)
+			if after == "" || strings.Trim(after, "0123456789.") != "" {
+				return r, fmt.Errorf("invalid version %q in %q", after, term)
+			}
+			break
+		}
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	if r.op == opAny {
+		if before, ok := strings.CutSuffix(name, " esr"); ok {
+			name = before
+			r.channel = agents.ChannelESR
+		}
+	}
+
+	browser, ok := browserNames[name]
+	if !ok {
+		return r, fmt.Errorf("unknown browser %q", strings.TrimSpace(name))
+	}
+	r.browser = browser
+
+	if r.channel == agents.ChannelESR && browser != agents.BrowserFirefox {
+		return r, fmt.Errorf("only Firefox has an ESR channel in %q", term)
+	}
+
+	return r, nil
+}
+
+// matches returns true if the user agent satisfies the rule.
+func (r rule) matches(ua *useragent.UserAgent) bool {
+	if ua.Browser() != r.browser {
+		return false
+	}
+
+	if r.channel != "" && ua.BrowserChannel() != r.channel {
+		return false
+	}
+
+	if r.op == opAny {
+		return true
+	}
+
+	version := ua.Version()
+	if version.IsZero() {
+		return false
+	}
+
+	cmp := version.Compare(r.version)
+	switch r.op {
+	case opGreaterEqual:
+		return cmp >= 0
+	case opGreater:
+		return cmp > 0
+	case opLessEqual:
+		return cmp <= 0
+	case opLess:
+		return cmp < 0
+	}
+
+	return false
+}
+
+// Allows returns true if the user agent is supported by the policy. User
+// agents without a version only match terms without a version.
+func (p *Policy) Allows(ua useragent.UserAgent) bool {
+	for i := range p.deny {
+		if p.deny[i].matches(&ua) {
+			return false
+		}
+	}
+
+	if len(p.allow) == 0 {
+		return true
+	}
+
+	for i := range p.allow {
+		if p.allow[i].matches(&ua) {
+			return true
+		}
+	}
+
+	return false
+}
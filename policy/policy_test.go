@@ -0,0 +1,88 @@
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	ua "github.com/medama-io/go-useragent"
+	"github.com/medama-io/go-useragent/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	chrome118  = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"
+	chrome100  = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/100.0.4896.127 Safari/537.36"
+	safari154  = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.4 Safari/605.1.15"
+	safari153  = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.3 Safari/605.1.15"
+	firefoxESR = "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:140.0) Gecko/20100101 Firefox/140.0"
+	firefox141 = "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:141.0) Gecko/20100101 Firefox/141.0"
+	ie11       = "Mozilla/5.0 (Windows NT 10.0; WOW64; Trident/7.0; rv:11.0) like Gecko"
+	samsung    = "Mozilla/5.0 (Linux; Android 13; SM-S911B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36"
+)
+
+func TestAllows(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		Query     string
+		UserAgent string
+		Allowed   bool
+	}{
+		{"Chrome >= 109, Safari >= 15.4, Firefox ESR, no IE", chrome118, true},
+		{"Chrome >= 109, Safari >= 15.4, Firefox ESR, no IE", chrome100, false},
+		{"Chrome >= 109, Safari >= 15.4, Firefox ESR, no IE", safari154, true},
+		{"Chrome >= 109, Safari >= 15.4, Firefox ESR, no IE", safari153, false},
+		{"Chrome >= 109, Safari >= 15.4, Firefox ESR, no IE", firefoxESR, true},
+		{"Chrome >= 109, Safari >= 15.4, Firefox ESR, no IE", firefox141, false},
+		{"Chrome >= 109, Safari >= 15.4, Firefox ESR, no IE", ie11, false},
+		{"Chrome >= 109, Safari >= 15.4, Firefox ESR, no IE", samsung, false},
+		{"not IE", samsung, true},
+		{"not IE", ie11, false},
+		{"not Chrome < 109", chrome100, false},
+		{"not Chrome < 109", chrome118, true},
+		{"chrome > 118", chrome118, false},
+		{"Chrome <= 118", chrome118, true},
+		{"Samsung Browser >= 23", samsung, true},
+		{"samsung >= 24", samsung, false},
+		{"Firefox, not Firefox ESR", firefoxESR, false},
+		{"Firefox, not Firefox ESR", firefox141, true},
+		{"Chrome", "curl/8.4.0", false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			p, err := policy.Compile(c.Query)
+			require.NoError(t, err)
+			assert.Equal(t, c.Allowed, p.Allows(parser.Parse(c.UserAgent)), "Allowed\nQuery: %s\nTest Case: %s", c.Query, c.UserAgent)
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for i, query := range []string{
+		"",
+		" , ",
+		"Netscape >= 4",
+		"Chrome >= ",
+		"Chrome >= latest",
+		"Safari ESR",
+	} {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			_, err := policy.Compile(query)
+			assert.Error(t, err, "Query: %s", query)
+		})
+	}
+
+	assert.Panics(t, func() { policy.MustCompile("Netscape") })
+}
+
+func TestAllowsAllocs(t *testing.T) {
+	p := policy.MustCompile("Chrome >= 109, Safari >= 15.4, Firefox ESR, no IE")
+	agent := ua.NewParser().Parse(firefoxESR)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = p.Allows(agent)
+	})
+	assert.Zero(t, allocs)
+}
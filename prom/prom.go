@@ -0,0 +1,227 @@
+// Package prom counts parsed requests as Prometheus metrics, labelled by
+// browser, major version, OS, device and bot category.
+//
+// It is a separate module so the parser does not depend on Prometheus.
+package prom
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/medama-io/go-useragent"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Other is the label value reported once a label has reached its limit.
+const Other = "other"
+
+// Unknown is the label value reported when the value could not be parsed.
+const Unknown = "unknown"
+
+// Bot categories reported by the bot label.
+const (
+	CategoryHuman     = "human"
+	CategoryCrawler   = "crawler"
+	CategoryAICrawler = "ai_crawler"
+	CategoryLibrary   = "library"
+)
+
+// Options are the options for a Collector. A zero value uses the defaults.
+type Options struct {
+	// Namespace is prefixed to the metric name.
+	Namespace string
+	// Limit is the number of most common values kept for the browser, version
+	// and OS labels. Defaults to 20.
+	Limit int
+}
+
+// Collector is a prometheus.Collector counting parsed requests. It is safe for
+// concurrent use.
+//
+// To bound the label cardinality, only the Limit most common values of each of
+// the browser, version and OS labels are kept, and other values are reported as
+// Other. Once a value becomes more common than a kept one, it replaces it and
+// the series of the replaced value are removed.
+type Collector struct {
+	requests *prometheus.CounterVec
+	parser   *useragent.Parser
+
+	browsers *labelSet
+	versions *labelSet
+	oses     *labelSet
+}
+
+// New creates a new Collector. If opts is nil, the default options are used.
+func New(opts *Options) *Collector {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.Limit <= 0 {
+		o.Limit = 20
+	}
+
+	c := &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: o.Namespace,
+			Name:      "useragent_requests_total",
+			Help:      "Number of requests by parsed user agent.",
+		}, []string{"browser", "version", "os", "device", "bot"}),
+		parser: useragent.NewParser(),
+	}
+	c.browsers = newLabelSet(o.Limit, c.deleteSeries("browser"))
+	c.versions = newLabelSet(o.Limit, c.deleteSeries("version"))
+	c.oses = newLabelSet(o.Limit, c.deleteSeries("os"))
+
+	return c
+}
+
+// deleteSeries returns a function removing the series with the given value of
+// the label.
+func (c *Collector) deleteSeries(label string) func(v string) {
+	return func(v string) {
+		c.requests.DeletePartialMatch(prometheus.Labels{label: v})
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+}
+
+// Observe counts a parsed user agent.
+func (c *Collector) Observe(ua useragent.UserAgent) {
+	version := ""
+	if major := ua.Version().Major; major != 0 {
+		version = strconv.Itoa(major)
+	}
+
+	c.requests.WithLabelValues(
+		c.browsers.value(string(ua.Browser())),
+		c.versions.value(version),
+		c.oses.value(string(ua.OS())),
+		labelValue(string(ua.Device())),
+		category(ua),
+	).Inc()
+}
+
+// Middleware parses the user agent and Client Hints of each request and counts
+// them before calling next.
+func (c *Collector) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Observe(c.parser.ParseWithHints(r.UserAgent(), useragent.HintsFromHeader(r.Header)))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// category returns the bot category of the user agent.
+func category(ua useragent.UserAgent) string {
+	switch {
+	case ua.IsAICrawler():
+		return CategoryAICrawler
+	case ua.IsBot():
+		return CategoryCrawler
+	case ua.IsLibrary():
+		return CategoryLibrary
+	}
+	return CategoryHuman
+}
+
+// labelValue returns Unknown for empty values.
+func labelValue(v string) string {
+	if v == "" {
+		return Unknown
+	}
+	return v
+}
+
+// sketchFactor is the number of values counted for each value kept, so values
+// that are not kept yet are counted long enough to replace less common ones.
+const sketchFactor = 8
+
+// labelSet keeps the most common values of a label up to its limit. Values are
+// counted with the Space-Saving algorithm, which approximates the counts of
+// the most common values in a fixed amount of memory.
+type labelSet struct {
+	mu     sync.Mutex
+	counts map[string]uint64
+	kept   map[string]struct{}
+	limit  int
+	// evict is called with a kept value once it is replaced by a more common
+	// one.
+	evict func(v string)
+}
+
+func newLabelSet(limit int, evict func(v string)) *labelSet {
+	return &labelSet{
+		counts: make(map[string]uint64, limit*sketchFactor),
+		kept:   make(map[string]struct{}, limit),
+		limit:  limit,
+		evict:  evict,
+	}
+}
+
+// value counts v and returns it if it is one of the most common values, or
+// Other if it is not.
+func (s *labelSet) value(v string) string {
+	v = labelValue(v)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := s.count(v)
+	if _, ok := s.kept[v]; ok {
+		return v
+	}
+	if len(s.kept) < s.limit {
+		s.kept[v] = struct{}{}
+		return v
+	}
+
+	// Replace the least common kept value once v is more common.
+	least, leastCount := "", uint64(math.MaxUint64)
+	for k := range s.kept {
+		if c := s.counts[k]; c < leastCount {
+			least, leastCount = k, c
+		}
+	}
+	if count <= leastCount {
+		return Other
+	}
+
+	delete(s.kept, least)
+	s.kept[v] = struct{}{}
+	s.evict(least)
+	return v
+}
+
+// count increments and returns the approximate count of v. Once the sketch is
+// full, the least common value is replaced by v, which takes over its count.
+func (s *labelSet) count(v string) uint64 {
+	if c, ok := s.counts[v]; ok {
+		s.counts[v] = c + 1
+		return c + 1
+	}
+
+	var min uint64
+	if len(s.counts) >= s.limit*sketchFactor {
+		least := ""
+		min = math.MaxUint64
+		for k, c := range s.counts {
+			if c < min {
+				least, min = k, c
+			}
+		}
+		delete(s.counts, least)
+	}
+
+	s.counts[v] = min + 1
+	return min + 1
+}
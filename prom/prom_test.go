@@ -0,0 +1,72 @@
+package prom_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/medama-io/go-useragent"
+	"github.com/medama-io/go-useragent/prom"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserve(t *testing.T) {
+	parser := useragent.NewParser()
+	collector := prom.New(&prom.Options{Limit: 2})
+
+	collector.Observe(parser.Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"))
+	collector.Observe(parser.Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36"))
+	collector.Observe(parser.Parse("Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:109.0) Gecko/20100101 Firefox/119.0"))
+	collector.Observe(parser.Parse("Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko); compatible; GPTBot/1.2; +https://openai.com/gptbot"))
+	collector.Observe(parser.Parse("curl/8.4.0"))
+
+	expected := `
+# HELP useragent_requests_total Number of requests by parsed user agent.
+# TYPE useragent_requests_total counter
+useragent_requests_total{bot="ai_crawler",browser="other",device="Bot",os="other",version="other"} 1
+useragent_requests_total{bot="human",browser="Chrome",device="Desktop",os="Windows",version="118"} 2
+useragent_requests_total{bot="library",browser="other",device="Library",os="unknown",version="other"} 1
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func TestObserveMostCommon(t *testing.T) {
+	parser := useragent.NewParser()
+	collector := prom.New(&prom.Options{Limit: 1})
+
+	firefox := parser.Parse("Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:109.0) Gecko/20100101 Firefox/119.0")
+	chrome := parser.Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36")
+
+	// Chrome replaces Firefox once it is more common.
+	collector.Observe(firefox)
+	collector.Observe(chrome)
+	collector.Observe(chrome)
+	collector.Observe(chrome)
+
+	expected := `
+# HELP useragent_requests_total Number of requests by parsed user agent.
+# TYPE useragent_requests_total counter
+useragent_requests_total{bot="human",browser="Chrome",device="Desktop",os="Windows",version="118"} 2
+useragent_requests_total{bot="human",browser="other",device="Desktop",os="other",version="other"} 1
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func TestMiddleware(t *testing.T) {
+	collector := prom.New(nil)
+	called := false
+	handler := collector.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.True(t, called)
+	assert.Equal(t, 1, testutil.CollectAndCount(collector))
+	assert.Equal(t, 1.0, testutil.ToFloat64(collector))
+}
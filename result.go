@@ -0,0 +1,106 @@
+package useragent
+
+import (
+	"encoding/json"
+
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/medama-io/go-useragent/internal"
+)
+
+// Result is a flat copy of a parsed UserAgent with exported fields, which can
+// be logged, stored in a database or returned from an API.
+type Result struct {
+	Browser        agents.Browser      `json:"browser,omitempty"`
+	BrowserVersion string              `json:"browser_version,omitempty"`
+	BrowserChannel agents.Channel      `json:"browser_channel,omitempty"`
+	OS             agents.OS           `json:"os,omitempty"`
+	OSVersion      string              `json:"os_version,omitempty"`
+	Device         agents.Device       `json:"device,omitempty"`
+	DeviceModel    string              `json:"device_model,omitempty"`
+	DeviceVendor   agents.Vendor       `json:"device_vendor,omitempty"`
+	Engine         agents.Engine       `json:"engine,omitempty"`
+	EngineVersion  string              `json:"engine_version,omitempty"`
+	Architecture   agents.Architecture `json:"architecture,omitempty"`
+	Is64Bit        bool                `json:"is_64_bit,omitempty"`
+	InAppBrowser   agents.App          `json:"in_app_browser,omitempty"`
+	WebView        bool                `json:"webview,omitempty"`
+	AICrawler      bool                `json:"ai_crawler,omitempty"`
+	AmbiguousIPad  bool                `json:"ambiguous_ipad,omitempty"`
+}
+
+// Result returns the parsed values as a Result.
+func (ua UserAgent) Result() Result {
+	r := Result{
+		Browser:        ua.Browser(),
+		BrowserVersion: ua.BrowserVersion(),
+		BrowserChannel: ua.BrowserChannel(),
+		OS:             ua.OS(),
+		OSVersion:      string(ua.osVersion[:ua.osVersionIndex]),
+		Device:         ua.Device(),
+		DeviceModel:    ua.DeviceModel(),
+		DeviceVendor:   ua.DeviceVendor(),
+		Engine:         ua.Engine(),
+		EngineVersion:  ua.EngineVersion(),
+		Is64Bit:        ua.Is64Bit(),
+		InAppBrowser:   ua.InAppBrowser(),
+		WebView:        ua.IsWebView(),
+		AICrawler:      ua.IsAICrawler(),
+		AmbiguousIPad:  ua.AmbiguousIPad(),
+	}
+
+	// The unknown architecture is left empty so it is omitted.
+	if ua.arch != internal.Unknown {
+		r.Architecture = ua.Architecture()
+	}
+
+	return r
+}
+
+// UserAgent restores the UserAgent from a Result, so its helper methods can be
+// used on stored results. Unknown names are left empty.
+func (r Result) UserAgent() UserAgent {
+	ua := UserAgent{
+		browser: internal.MatchFromName(internal.MatchBrowser, string(r.Browser)),
+		os:      internal.MatchFromName(internal.MatchOS, string(r.OS)),
+		device:  internal.MatchFromName(internal.MatchDevice, string(r.Device)),
+		engine:  internal.MatchFromName(internal.MatchEngine, string(r.Engine)),
+		arch:    internal.MatchFromName(internal.MatchArch, string(r.Architecture)),
+		app:     internal.MatchFromName(internal.MatchApp, string(r.InAppBrowser)),
+
+		channel:       r.BrowserChannel,
+		model:         r.DeviceModel,
+		vendor:        r.DeviceVendor,
+		webView:       r.WebView,
+		ambiguousIPad: r.AmbiguousIPad,
+		is64Bit:       r.Is64Bit,
+		aiCrawler:     r.AICrawler,
+	}
+
+	ua.setVersion(r.BrowserVersion)
+	ua.setOSVersion(r.OSVersion)
+	for _, c := range r.EngineVersion {
+		if ua.engineVersionIndex == cap(ua.engineVersion) {
+			break
+		}
+		ua.engineVersion[ua.engineVersionIndex] = c
+		ua.engineVersionIndex++
+	}
+
+	return ua
+}
+
+// MarshalJSON implements json.Marshaler by encoding the Result.
+func (ua UserAgent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ua.Result())
+}
+
+// UnmarshalJSON implements json.Unmarshaler by decoding a Result.
+func (ua *UserAgent) UnmarshalJSON(data []byte) error {
+	var r Result
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+
+	*ua = r.UserAgent()
+	return nil
+}
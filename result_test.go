@@ -0,0 +1,84 @@
+package useragent_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	ua "github.com/medama-io/go-useragent"
+
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/medama-io/go-useragent/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultJSON(t *testing.T) {
+	parser := ua.NewParser()
+	result := parser.Parse("Mozilla/5.0 (Linux; Android 13; SM-S911B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36")
+
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"browser": "Samsung Browser",
+		"browser_version": "23.0",
+		"os": "Android",
+		"os_version": "13",
+		"device": "Mobile",
+		"device_model": "SM-S911B",
+		"device_vendor": "Samsung",
+		"engine": "Blink",
+		"engine_version": "115.0.0.0"
+	}`, string(data))
+
+	var restored ua.UserAgent
+	require.NoError(t, json.Unmarshal(data, &restored))
+	assert.Equal(t, result.Result(), restored.Result())
+	assert.True(t, restored.IsMobile())
+	assert.Equal(t, 13, restored.OSVersion().Major)
+	assert.Empty(t, restored.Raw())
+	assert.Contains(t, result.Raw(), "SamsungBrowser/23.0")
+
+	assert.Error(t, json.Unmarshal([]byte(`{"browser": 1}`), &restored))
+}
+
+func TestResultRoundTrip(t *testing.T) {
+	parser := ua.NewParser()
+
+	for i, c := range testdata.TestCases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c)
+
+			data, err := json.Marshal(result)
+			require.NoError(t, err)
+
+			var restored ua.UserAgent
+			require.NoError(t, json.Unmarshal(data, &restored))
+			assert.Equal(t, result.Result(), restored.Result(), "Test Case: %s", c)
+		})
+	}
+}
+
+func TestAgentsSQL(t *testing.T) {
+	var browser agents.Browser
+	assert.NoError(t, browser.Scan("Chrome"))
+	assert.Equal(t, agents.BrowserChrome, browser)
+	assert.NoError(t, browser.Scan([]byte("Firefox")))
+	assert.Equal(t, agents.BrowserFirefox, browser)
+	assert.NoError(t, browser.Scan(nil))
+	assert.Equal(t, agents.Browser(""), browser)
+	assert.Error(t, browser.Scan(42))
+
+	value, err := agents.OSWindows.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, driver.Value("Windows"), value)
+
+	var device agents.Device
+	assert.NoError(t, device.UnmarshalText([]byte("Tablet")))
+	assert.Equal(t, agents.DeviceTablet, device)
+
+	text, err := agents.DeviceTablet.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "Tablet", string(text))
+}
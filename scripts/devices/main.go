@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/medama-io/go-useragent/devices"
+)
+
+// This validates the device table and rewrites it sorted by vendor and model
+// with duplicates removed, so new rows can be appended in any order.
+//
+// The table is maintained by hand. There is no upstream source it can be
+// regenerated from, so new devices must be added as rows to the CSV first.
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Println("usage: go run scripts/devices/main.go <devices.csv>")
+		os.Exit(1)
+	}
+
+	filePath := os.Args[1]
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	list, err := devices.Parse(string(content))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Vendor != list[j].Vendor {
+			return list[i].Vendor < list[j].Vendor
+		}
+		return list[i].Model < list[j].Model
+	})
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"model", "vendor", "name", "year", "form_factor"})
+
+	seen := make(map[string]bool)
+	for _, d := range list {
+		key := devices.NormaliseModel(d.Model)
+		if seen[key] {
+			fmt.Printf("skipping duplicate model %q\n", d.Model)
+			continue
+		}
+		seen[key] = true
+
+		_ = w.Write([]string{d.Model, d.Vendor.String(), d.Name, strconv.Itoa(d.Year), d.FormFactor.String()})
+	}
+	w.Flush()
+
+	if err := os.WriteFile(filePath, buf.Bytes(), 0o644); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Wrote %d devices to %s\n", len(seen), filePath)
+}
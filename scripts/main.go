@@ -29,14 +29,18 @@ func CleanAgentsFile(filePath string) ([]string, error) {
 			continue
 		}
 
+		// Skip injected scripts, except the gRPC-Web client which has
+		// "javascript" in its name.
 		lineLower := strings.ToLower(line)
-		if strings.Contains(lineLower, "javascript") || strings.Contains(lineLower, "function") || strings.Contains(lineLower, "quot") || strings.Contains(lineLower, "parent") {
+		if strings.Contains(lineLower, "javascript") && !strings.Contains(lineLower, "grpc-web-javascript") || strings.Contains(lineLower, "function") || strings.Contains(lineLower, "quot") || strings.Contains(lineLower, "parent") {
 			continue
 		}
 
-		// Cut the line after the first "[" is used.
-		if strings.Contains(line, "[") {
-			line = line[:strings.Index(line, "[")]
+		// Cut the line after the first "[" is used, unless it is used by an
+		// in-app browser such as "[FBAN/FBIOS;...]" or "[LinkedInApp]".
+		if i := strings.Index(line, "["); i >= 0 &&
+			!strings.HasPrefix(line[i:], "[FB") && !strings.HasPrefix(line[i:], "[LinkedInApp") {
+			line = line[:i]
 		}
 
 		line = internal.RemoveMobileIdentifiers(line)
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/medama-io/go-useragent/internal"
+)
+
+// endOfLifeProducts maps the browsers and operating systems in the release
+// table to their endoflife.date product names.
+var endOfLifeProducts = []struct {
+	kind    internal.ReleaseKind
+	name    string
+	product string
+}{
+	{internal.ReleaseBrowser, "Firefox", "firefox"},
+	{internal.ReleaseBrowser, "Safari", "safari"},
+	{internal.ReleaseOS, "Android", "android"},
+	{internal.ReleaseOS, "iOS", "ios"},
+	{internal.ReleaseOS, "MacOS", "macos"},
+}
+
+// firstChromeMilestone is the oldest Chrome release kept in the table, which
+// is the last release supporting Windows 7.
+const firstChromeMilestone = 109
+
+// frozenMacOSVersion is the macOS version reported by every newer release, so
+// it is supported as long as macOS is.
+const frozenMacOSVersion = "10.15"
+
+// Edge ships the same milestone as Chrome a few days later.
+const edgeReleaseDelay = 3 * 24 * time.Hour
+
+var client = &http.Client{Timeout: 30 * time.Second}
+
+// This refreshes the release table from the Chromium schedule and
+// endoflife.date, then validates and rewrites it sorted by product and
+// version. Products that fail to download and products without a source, such
+// as Windows and IE, keep their existing rows.
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Println("usage: go run scripts/releases/main.go <releases.csv>")
+		os.Exit(1)
+	}
+
+	filePath := os.Args[1]
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	list, err := internal.ParseReleases(string(content))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if chrome, err := fetchChrome(); err != nil {
+		fmt.Println("skipping Chrome and Edge:", err)
+	} else {
+		list = replace(list, internal.ReleaseBrowser, "Chrome", chrome)
+
+		var edge []internal.Release
+		for _, r := range chrome {
+			r.Name = "Edge"
+			r.Released = r.Released.Add(edgeReleaseDelay)
+			edge = append(edge, r)
+		}
+		// Keep early Chromium releases older than the schedule.
+		list = replace(list, internal.ReleaseBrowser, "Edge", edge, chrome[0].Version)
+	}
+
+	for _, p := range endOfLifeProducts {
+		releases, err := fetchEndOfLife(p.kind, p.name, p.product)
+		if err != nil {
+			fmt.Printf("skipping %s: %v\n", p.name, err)
+			continue
+		}
+		list = replace(list, p.kind, p.name, releases)
+	}
+
+	slices.SortStableFunc(list, func(a, b internal.Release) int {
+		if a.Kind != b.Kind {
+			return cmp.Compare(a.Kind, b.Kind)
+		}
+		if a.Name != b.Name {
+			return cmp.Compare(a.Name, b.Name)
+		}
+		return compareVersions(a.Version, b.Version)
+	})
+	list = slices.CompactFunc(list, func(a, b internal.Release) bool {
+		return a.Kind == b.Kind && a.Name == b.Name && a.Version == b.Version
+	})
+
+	if err := os.WriteFile(filePath, []byte(internal.FormatReleases(list)), 0o644); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Wrote %d releases to %s\n", len(list), filePath)
+}
+
+// replace swaps the rows of a product with newer ones. If keepBefore is set,
+// existing rows with an older version are kept.
+func replace(list []internal.Release, kind internal.ReleaseKind, name string, releases []internal.Release, keepBefore ...string) []internal.Release {
+	list = slices.DeleteFunc(list, func(r internal.Release) bool {
+		if r.Kind != kind || r.Name != name {
+			return false
+		}
+		return len(keepBefore) == 0 || compareVersions(r.Version, keepBefore[0]) >= 0
+	})
+	return append(list, releases...)
+}
+
+// fetchChrome returns the stable release dates of each Chrome milestone until
+// the first milestone that has not been released yet.
+func fetchChrome() ([]internal.Release, error) {
+	var releases []internal.Release
+	for mstone := firstChromeMilestone; ; mstone++ {
+		var schedule struct {
+			Mstones []struct {
+				StableDate string `json:"stable_date"`
+			} `json:"mstones"`
+		}
+		url := fmt.Sprintf("https://chromiumdash.appspot.com/fetch_milestone_schedule?mstone=%d", mstone)
+		if err := fetchJSON(url, &schedule); err != nil {
+			return nil, err
+		}
+		if len(schedule.Mstones) == 0 {
+			break
+		}
+
+		released, err := time.Parse("2006-01-02T15:04:05", schedule.Mstones[0].StableDate)
+		if err != nil {
+			return nil, fmt.Errorf("milestone %d: %w", mstone, err)
+		}
+		if released.After(time.Now()) {
+			break
+		}
+
+		releases = append(releases, internal.Release{
+			Kind:     internal.ReleaseBrowser,
+			Name:     "Chrome",
+			Version:  strconv.Itoa(mstone),
+			Released: released,
+		})
+	}
+
+	if len(releases) == 0 {
+		return nil, fmt.Errorf("no milestones found")
+	}
+
+	return releases, nil
+}
+
+// fetchEndOfLife returns the release cycles of a product from endoflife.date.
+// Browsers are only supported until their next release, so end of life dates
+// are only kept for long term support releases such as Firefox ESR.
+func fetchEndOfLife(kind internal.ReleaseKind, name, product string) ([]internal.Release, error) {
+	var cycles []struct {
+		Cycle       string `json:"cycle"`
+		ReleaseDate string `json:"releaseDate"`
+		// EOL and LTS are either a boolean or a date.
+		EOL any `json:"eol"`
+		LTS any `json:"lts"`
+	}
+	if err := fetchJSON("https://endoflife.date/api/"+product+".json", &cycles); err != nil {
+		return nil, err
+	}
+
+	var releases []internal.Release
+	for _, c := range cycles {
+		if strings.Trim(c.Cycle, "0123456789.") != "" {
+			continue
+		}
+
+		// Newer versions of macOS report the frozen version instead.
+		if name == "MacOS" && compareVersions(c.Cycle, frozenMacOSVersion) > 0 {
+			continue
+		}
+
+		released, err := time.Parse(internal.ReleaseDateLayout, c.ReleaseDate)
+		if err != nil {
+			return nil, fmt.Errorf("cycle %s: %w", c.Cycle, err)
+		}
+
+		r := internal.Release{Kind: kind, Name: name, Version: c.Cycle, Released: released}
+		lts := c.LTS != nil && c.LTS != false
+		eol, hasEOL := c.EOL.(string)
+
+		switch {
+		case name == "MacOS" && c.Cycle == frozenMacOSVersion:
+			r.LongTerm = true
+		case kind == internal.ReleaseBrowser && !lts:
+		case hasEOL:
+			r.EOL, err = time.Parse(internal.ReleaseDateLayout, eol)
+			if err != nil {
+				return nil, fmt.Errorf("cycle %s: %w", c.Cycle, err)
+			}
+		case lts:
+			r.LongTerm = true
+		}
+
+		releases = append(releases, r)
+	}
+
+	if len(releases) == 0 {
+		return nil, fmt.Errorf("no release cycles found")
+	}
+
+	return releases, nil
+}
+
+func fetchJSON(url string, v any) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// compareVersions compares dotted version numbers numerically.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if c := cmp.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
@@ -0,0 +1,151 @@
+package useragent
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/medama-io/go-useragent/internal"
+)
+
+type releaseKey struct {
+	kind internal.ReleaseKind
+	name string
+}
+
+type release struct {
+	version  Version
+	released time.Time
+	eol      time.Time
+	longTerm bool
+}
+
+var (
+	releasesOnce sync.Once
+	// releases are the embedded release dates of each product, sorted by version.
+	releases map[releaseKey][]release
+)
+
+// loadReleases parses the embedded release table on first use, so it does not
+// affect parsing performance.
+func loadReleases() {
+	list, err := internal.ParseReleases(internal.ReleasesFile)
+	if err != nil {
+		// The embedded table is validated when it is generated.
+		panic("useragent: invalid embedded release table: " + err.Error())
+	}
+
+	releases = make(map[releaseKey][]release)
+	for _, r := range list {
+		key := releaseKey{kind: r.Kind, name: r.Name}
+		releases[key] = append(releases[key], release{
+			version:  ParseVersion(r.Version),
+			released: r.Released,
+			eol:      r.EOL,
+			longTerm: r.LongTerm,
+		})
+	}
+
+	for _, list := range releases {
+		slices.SortFunc(list, func(a, b release) int {
+			return a.version.Compare(b.version)
+		})
+	}
+}
+
+// releaseStatus returns the support status of a product version at the given
+// time. Versions newer than the table are assumed to be current, while
+// versions older than the table are outdated or end of life like the oldest
+// release in the table.
+func releaseStatus(kind internal.ReleaseKind, name string, version Version, now time.Time) agents.SupportStatus {
+	releasesOnce.Do(loadReleases)
+
+	list := releases[releaseKey{kind: kind, name: name}]
+	if len(list) == 0 || version.IsZero() {
+		return ""
+	}
+
+	// Find the release the version belongs to, e.g. "17.1.2" belongs to "17".
+	i, found := slices.BinarySearchFunc(list, version, func(r release, v Version) int {
+		return r.version.Compare(v)
+	})
+	older := false
+	switch {
+	case found:
+	case i == 0:
+		older = true
+	default:
+		i--
+	}
+	r := list[i]
+
+	switch {
+	case !r.eol.IsZero() && !now.Before(r.eol):
+		return agents.SupportEndOfLife
+	case older:
+		return agents.SupportOutdated
+	case r.longTerm || !r.eol.IsZero():
+		return agents.SupportCurrent
+	}
+
+	for _, newer := range list[i+1:] {
+		if !now.Before(newer.released) {
+			return agents.SupportOutdated
+		}
+	}
+
+	return agents.SupportCurrent
+}
+
+// supportRank orders support statuses from best to worst.
+func supportRank(s agents.SupportStatus) int {
+	switch s {
+	case agents.SupportCurrent:
+		return 1
+	case agents.SupportOutdated:
+		return 2
+	case agents.SupportEndOfLife:
+		return 3
+	}
+	return 0
+}
+
+// BrowserSupportStatus returns whether the browser version still receives
+// updates at the given time, using the embedded release table. If the browser
+// or its version is not in the table, it returns an empty string.
+func (ua UserAgent) BrowserSupportStatus(now time.Time) agents.SupportStatus {
+	return releaseStatus(internal.ReleaseBrowser, string(ua.Browser()), ua.Version(), now)
+}
+
+// OSSupportStatus returns whether the operating system version still receives
+// updates at the given time, using the embedded release table. If the OS or
+// its version is not in the table, it returns an empty string.
+func (ua UserAgent) OSSupportStatus(now time.Time) agents.SupportStatus {
+	return releaseStatus(internal.ReleaseOS, string(ua.OS()), ua.OSVersion(), now)
+}
+
+// SupportStatusAt returns the worst support status of the browser and
+// operating system at the given time. A release is outdated when a newer
+// release is available, and end of life once it no longer receives security
+// updates. If neither is in the release table, it returns an empty string.
+func (ua UserAgent) SupportStatusAt(now time.Time) agents.SupportStatus {
+	browser, os := ua.BrowserSupportStatus(now), ua.OSSupportStatus(now)
+	if supportRank(os) > supportRank(browser) {
+		return os
+	}
+	return browser
+}
+
+// SupportStatus returns the worst support status of the browser and operating
+// system today. See SupportStatusAt for details.
+func (ua UserAgent) SupportStatus() agents.SupportStatus {
+	return ua.SupportStatusAt(time.Now())
+}
+
+// IsOutdated returns true if the browser or operating system is outdated or
+// end of life at the given time.
+func (ua UserAgent) IsOutdated(now time.Time) bool {
+	status := ua.SupportStatusAt(now)
+	return status == agents.SupportOutdated || status == agents.SupportEndOfLife
+}
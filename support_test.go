@@ -0,0 +1,74 @@
+package useragent_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ua "github.com/medama-io/go-useragent"
+
+	"github.com/medama-io/go-useragent/agents"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportStatus(t *testing.T) {
+	parser := ua.NewParser()
+
+	jan2025 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	oct2025 := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		UserAgent string
+		Now       time.Time
+		Browser   agents.SupportStatus
+		OS        agents.SupportStatus
+	}{
+		// Latest Chrome on Windows 10/11.
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36", jan2025, agents.SupportCurrent, agents.SupportCurrent},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36", oct2025, agents.SupportOutdated, agents.SupportCurrent},
+		// Newer than the release table.
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/150.0.0.0 Safari/537.36", oct2025, agents.SupportCurrent, agents.SupportCurrent},
+		// Older than the release table.
+		{"Mozilla/5.0 (Windows NT 6.1; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/90.0.4430.212 Safari/537.36", jan2025, agents.SupportOutdated, agents.SupportEndOfLife},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0", jan2025, agents.SupportCurrent, agents.SupportCurrent},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:128.0) Gecko/20100101 Firefox/128.0", oct2025, agents.SupportEndOfLife, agents.SupportCurrent},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:140.0) Gecko/20100101 Firefox/140.0", oct2025, agents.SupportCurrent, agents.SupportCurrent},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:133.0) Gecko/20100101 Firefox/133.0", oct2025, agents.SupportOutdated, agents.SupportCurrent},
+		{"Mozilla/5.0 (Windows NT 10.0; WOW64; Trident/7.0; rv:11.0) like Gecko", jan2025, agents.SupportEndOfLife, agents.SupportCurrent},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edge/18.19045", jan2025, agents.SupportEndOfLife, agents.SupportCurrent},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/18.1 Safari/605.1.15", jan2025, agents.SupportCurrent, agents.SupportCurrent},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_13_6) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/13.1.2 Safari/605.1.15", jan2025, agents.SupportOutdated, agents.SupportEndOfLife},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1.2 Mobile/15E148 Safari/604.1", jan2025, agents.SupportOutdated, agents.SupportOutdated},
+		{"Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Mobile Safari/537.36", jan2025, agents.SupportCurrent, agents.SupportEndOfLife},
+		// The reduced user agent freezes the Android version.
+		{"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Mobile Safari/537.36", jan2025, agents.SupportCurrent, ""},
+		{"Mozilla/5.0 (Linux; Android 15; Pixel 9) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Mobile Safari/537.36", jan2025, agents.SupportCurrent, agents.SupportCurrent},
+		{"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36 Vivaldi/7.0.3495.27", jan2025, "", ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.Browser, result.BrowserSupportStatus(c.Now), "Browser\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.OS, result.OSSupportStatus(c.Now), "OS\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
+
+func TestIsOutdated(t *testing.T) {
+	parser := ua.NewParser()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	current := parser.Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36")
+	assert.Equal(t, agents.SupportCurrent, current.SupportStatusAt(now))
+	assert.False(t, current.IsOutdated(now))
+
+	// The operating system is end of life even though the browser is current.
+	windows7 := parser.Parse("Mozilla/5.0 (Windows NT 6.1; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36")
+	assert.Equal(t, agents.SupportEndOfLife, windows7.SupportStatusAt(now))
+	assert.True(t, windows7.IsOutdated(now))
+
+	unknown := parser.Parse("curl/8.4.0")
+	assert.Equal(t, agents.SupportStatus(""), unknown.SupportStatus())
+	assert.False(t, unknown.IsOutdated(now))
+}
@@ -2,7 +2,9 @@ package useragent
 
 import (
 	"slices"
+	"strings"
 
+	"github.com/medama-io/go-useragent/agents"
 	"github.com/medama-io/go-useragent/internal"
 )
 
@@ -27,6 +29,15 @@ const (
 	maxChildArraySize = 64
 )
 
+// captureTarget is a bitmask of the version buffers to write to when in
+// the stateVersion parsing state.
+type captureTarget uint8
+
+const (
+	captureBrowser captureTarget = 1 << iota
+	captureEngine
+)
+
 type resultItem struct {
 	Match internal.Match
 	// 0: Unknown, 1: Browser, 2: OS, 3: Type
@@ -34,6 +45,11 @@ type resultItem struct {
 	// Precedence value for each result type to determine which result
 	// should be overwritten.
 	Precedence uint8
+	// Results are stored on the node before the last rune of the token, so
+	// the rune is kept to tell apart tokens such as "OPR" and "OPX".
+	Rune rune
+	// Product is set if the token is only matched when followed by a slash.
+	Product bool
 }
 
 type childNode struct {
@@ -59,13 +75,22 @@ type RuneTrie struct {
 func (trie *RuneTrie) Get(key string) UserAgent {
 	state := stateDefault
 	node := trie
-	var ua UserAgent
+	ua := UserAgent{raw: key}
 
 	// Number of runes to skip when iterating over the trie. This is used
 	// to skip over version numbers or language codes.
 	var skipCount uint8
 	// This is used to determine how many nested parenthesis deep we are.
 	var closingParenthisisNestCount uint8
+	// This is used to determine which version buffers to write to. A token
+	// can be both a browser and an engine, e.g. "Chrome".
+	var capture captureTarget
+	// These are the bounds of the Android comment containing the device model.
+	var modelStart, modelEnd int
+
+	// AI crawler tokens are matched at the start of each word, including the
+	// first product of the user agent, e.g. "CCBot/2.0".
+	ua.matchAICrawler(key)
 
 	for i, r := range key {
 		if skipCount > 0 {
@@ -86,30 +111,36 @@ func (trie *RuneTrie) Get(key string) UserAgent {
 			case ')':
 				if closingParenthisisNestCount == 0 {
 					state = stateDefault
+					if modelStart > 0 && modelEnd == 0 {
+						modelEnd = i
+					}
 				} else {
 					closingParenthisisNestCount--
 				}
 			}
 
 		case stateVersion:
-			// In the case of Edg and Edge, skipCount = 1 might just put us on the slash.
-			// Ideally, we need to improve the matcher to choose Edge over Edg, but this is
-			// a quick fix for now.
-			if r == '/' {
-				continue
-			}
-
-			// If we encounter any unknown characters, we can assume the version number is over.
-			if !internal.IsDigit(r) && r != '.' {
-				state = stateDefault
-			} else {
-				// Add to rune buffer.
-				if ua.versionIndex < cap(ua.version) {
+			if internal.IsDigit(r) || r == '.' {
+				// Add to rune buffers.
+				if capture&captureBrowser != 0 && ua.versionIndex < cap(ua.version) {
 					ua.version[ua.versionIndex] = r
 					ua.versionIndex++
 				}
+
+				if capture&captureEngine != 0 && ua.engineVersionIndex < cap(ua.engineVersion) {
+					ua.engineVersion[ua.engineVersionIndex] = r
+					ua.engineVersionIndex++
+				}
+				continue
 			}
 
+			// If we encounter any unknown characters, we can assume the version number
+			// is over. The rune may start the next token, so it is still matched below,
+			// e.g. "Bot" in "DuckDuckGo-Favicons-Bot".
+			state = stateDefault
+			capture = 0
+			fallthrough
+
 		case stateDefault:
 			// Strip any other version numbers from other products to get more hits to the trie.
 			//
@@ -128,45 +159,114 @@ func (trie *RuneTrie) Get(key string) UserAgent {
 			}
 
 			switch r {
-			case ' ', ';', ')', '(', ',', '_', '-', '/':
+			case ' ', ';', '(':
+				// Architecture tokens contain digits, so we match them against the key
+				// at the start of each word before they are stripped.
+				if ua.arch == internal.Unknown && len(key) > i+1 && internal.IsArchTokenStart(key[i+1]) {
+					arch, is64Bit := internal.MatchArchToken(key[i+1:])
+					if arch != internal.Unknown {
+						ua.arch = arch
+					}
+					if is64Bit {
+						ua.is64Bit = true
+					}
+				}
+				ua.matchAICrawler(key[i+1:])
+				continue
+			case ')', ',', '_', '-', '/':
 				continue
 			}
 
 			// If result exists, we can append it to the value.
 			for _, result := range node.result {
+				if result.Rune != r {
+					continue
+				}
+
+				// Tokens that are also common words are only matched as a product
+				// name, e.g. "Line/13.21.0" but not "onLine" or "Linespider".
+				if result.Product && (len(key) <= i+1 || key[i+1] != '/') {
+					continue
+				}
+
+				// A token is only complete if it is not directly followed by another
+				// letter, e.g. "Java" in "JavaFX". Otherwise looking for a version
+				// number would skip over the start of the next token.
+				tokenEnd := len(key) <= i+1 || !internal.IsLetter(rune(key[i+1]))
+
+				// A browser token followed by more letters may be the start of a
+				// longer browser token, e.g. "Edg" in "Edge/18". The trie only has a
+				// path to the longer token if the corpus does, so the complete word
+				// is looked up instead and preferred if it is a browser token.
+				var wordEnd int
+				if !tokenEnd && result.Type == internal.MatchBrowser {
+					start, end := wordBounds(key, i)
+					if m, ok := internal.MatchBrowserToken(key[start:end]); ok && m != result.Match {
+						result = resultItem{Match: m, Type: internal.MatchBrowser, Precedence: internal.MatchPrecedenceMap[m], Rune: r}
+						tokenEnd = true
+						wordEnd = end
+					}
+				}
+
 				matched := ua.addMatch(result)
 
 				// If we matched a browser of the highest precedence, we can mark the
-				// next set of runes as the version number we want to store.
+				// next set of runes as the version number we want to store. We also
+				// do this if we see the complete token of the current browser again
+				// without a version, in case it was first matched on a shorter token.
 				//
 				// We also reject any version numbers related to Safari since it has a
 				// separate key for its version number.
-				if (matched && result.Type == internal.MatchBrowser &&
-					result.Match != internal.BrowserSafari) ||
-					(result.Type == internal.MatchVersion &&
-						ua.versionIndex == 0) {
-					// Clear version buffer if it has old values.
-					if ua.versionIndex > 0 {
-						ua.version = [32]rune{}
-						ua.versionIndex = 0
-					}
+				isBrowser := result.Type == internal.MatchBrowser && result.Match != internal.BrowserSafari
+
+				// Clear version buffer if it has old values from a lower precedence
+				// browser, even if we can't capture a new version yet.
+				if matched && isBrowser && ua.versionIndex > 0 {
+					ua.version = [32]rune{}
+					ua.versionIndex = 0
+				}
 
-					// We want to omit the slash after the browser name.
+				if tokenEnd && (((matched || (result.Match == ua.browser && ua.versionIndex == 0)) && isBrowser) ||
+					(result.Type == internal.MatchVersion && ua.versionIndex == 0)) {
+					// We want to omit the slash after the browser name, and the rest of
+					// the word if a longer token was preferred.
 					skipCount = 1
+					if wordEnd > i {
+						skipCount += uint8(wordEnd - i - 1)
+					}
 					state = stateVersion
+					capture |= captureBrowser
+				}
+
+				// If we matched an engine, we store the version number following it
+				// in a separate buffer. Engine tokens such as "like Gecko" are often
+				// not followed by a version, so we only look for one after a slash.
+				if matched && result.Type == internal.MatchEngine {
+					ua.engineVersion = [32]rune{}
+					ua.engineVersionIndex = 0
+
+					if len(key) > i+1 && key[i+1] == '/' {
+						skipCount = 1
+						state = stateVersion
+						capture |= captureEngine
+					}
 				}
 
 				// If we matched a mobile token, we want to strip everything after it
 				// until we reach whitespace to get around random device IDs.
 				// For example, "Mobile/14F89" should be "Mobile".
-				if matched && result.Match == internal.DeviceMobile {
+				if result.Match == internal.DeviceMobile {
 					state = stateSkipWhitespace
 				}
 
 				// If we matched an Android token, we want to strip everything after it until
-				// we reach a closing parenthesis to get around random device IDs.
+				// we reach a closing parenthesis to get around random device IDs. We keep
+				// track of where it starts to extract the device model later.
 				if matched && result.Match == internal.OSAndroid {
 					state = stateSkipClosingParenthesis
+					if len(key) > i+1 && (key[i+1] == ' ' || key[i+1] == ';') {
+						modelStart = i + 1
+					}
 				}
 			}
 
@@ -190,6 +290,165 @@ func (trie *RuneTrie) Get(key string) UserAgent {
 		}
 	}
 
+	// Since iPadOS 13, iPads request the desktop site by default using a Mac
+	// user agent. Browsers only available on iOS give them away, otherwise
+	// the user agent is identical to Safari on a Mac.
+	if ua.os == internal.OSMacOS && ua.device == internal.DeviceDesktop {
+		switch {
+		case strings.Contains(key, "iOS/"):
+			ua.setIPad()
+		case ua.engine == internal.EngineWebKit && ua.browser == internal.BrowserSafari && strings.Contains(key, "Mac OS X 10_15"):
+			ua.ambiguousIPad = true
+		}
+	}
+
+	// Windows Phone includes the Touch token and the Xbox token for Xbox Live
+	// integration. Its Android compatibility token also hides the IE tokens in
+	// the skipped device comment, leaving only the WebKit compatibility tokens.
+	if ua.os == internal.OSWindowsPhone {
+		if ua.device == internal.DeviceTablet || ua.device == internal.DeviceConsole {
+			ua.device = internal.DeviceMobile
+		}
+		if (ua.browser == internal.BrowserAndroid || ua.browser == internal.BrowserSafari) && strings.Contains(key, "Trident") {
+			ua.browser = internal.BrowserIE
+			ua.engine = internal.EngineTrident
+			ua.engineVersion = [32]rune{}
+			ua.engineVersionIndex = 0
+		}
+	}
+
+	// Tizen leaves the device to the other tokens, so phones and watches that
+	// only match the generic Linux token must not be reported as a desktop.
+	if ua.os == internal.OSTizen && ua.device == internal.DeviceDesktop && strings.Contains(key, "Mobile") {
+		ua.device = internal.DeviceMobile
+	}
+
+	// LG TVs spell webOS with a zero, which is stripped with the version numbers.
+	if ua.os == internal.OSLinux && strings.Contains(key, "Web0S") {
+		ua.os = internal.OSWebOS
+	}
+
+	// Extract the device model from the Android comment, or use the device
+	// family for Apple devices which don't include the model.
+	if modelStart > 0 {
+		if modelEnd == 0 {
+			modelEnd = len(key)
+		}
+		ua.vendor, ua.model = internal.MatchVendor(internal.ParseAndroidModel(key[modelStart:modelEnd]))
+		ua.webView = internal.IsAndroidWebView(key[modelStart:modelEnd])
+
+		// HarmonyOS devices keep the Android token for compatibility.
+		if internal.IsHarmonyOS(key[modelStart:modelEnd]) {
+			ua.os = internal.OSHarmonyOS
+		}
+
+		// Wear OS watches and Fire tablets use the same user agent as phones, so
+		// they can only be told apart by the model, e.g. "Pixel Watch 2".
+		if ua.device == internal.DeviceMobile {
+			if device, ok := internal.MatchModelDevice(ua.model); ok {
+				ua.device = device
+			}
+		}
+	} else if ua.os == internal.OSIOS {
+		ua.vendor = agents.VendorApple
+		switch {
+		case strings.Contains(key, "iPad"):
+			ua.model = "iPad"
+		case strings.Contains(key, "iPod"):
+			ua.model = "iPod"
+		case strings.Contains(key, "iPhone"):
+			ua.model = "iPhone"
+		}
+
+		// Every WebKit browser on iOS includes the Safari token, except for WKWebView.
+		ua.webView = ua.engine == internal.EngineWebKit &&
+			(ua.device == internal.DeviceMobile || ua.device == internal.DeviceTablet) &&
+			!strings.Contains(key, "Safari/")
+	}
+
+	// In-app browsers are always embedded in a WebView. Apps also send the same
+	// token with their own API requests, which have no rendering engine.
+	if ua.app != internal.Unknown {
+		if ua.engine == internal.Unknown {
+			ua.app = internal.Unknown
+		} else {
+			ua.webView = true
+		}
+	}
+
+	// Firefox pre-release builds suffix the version, e.g. "Firefox/125.0a1"
+	// for Nightly and "Firefox/124.0b9" for Beta.
+	if ua.browser == internal.BrowserFirefox && ua.versionIndex > 0 {
+		if _, after, ok := strings.Cut(key, "Firefox/"); ok {
+			suffix := strings.TrimLeft(after, "0123456789.")
+			switch {
+			case strings.HasPrefix(suffix, "a"):
+				ua.channel = agents.ChannelNightly
+			case strings.HasPrefix(suffix, "b"):
+				ua.channel = agents.ChannelBeta
+			}
+		}
+	}
+
+	// Digits are stripped like version numbers, so browser tokens containing
+	// them such as "w3m" can't be stored in the trie. These are always the
+	// first product in the user agent, so it is looked up directly instead.
+	if ua.browser == internal.Unknown {
+		if end := strings.IndexAny(key, "/ "); end > 0 {
+			if browser, ok := internal.MatchBrowserToken(key[:end]); ok {
+				ua.browser = browser
+			}
+		}
+	}
+
+	// Edge Legacy includes a Chrome token, but is rendered with EdgeHTML
+	// which shares its version with the "Edge" token.
+	if ua.browser == internal.BrowserEdgeLegacy {
+		ua.engine = internal.EngineEdgeHTML
+		ua.engineVersion = ua.version
+		ua.engineVersionIndex = ua.versionIndex
+	}
+
+	switch ua.engine {
+	case internal.EngineGecko:
+		// The Gecko token is usually followed by a frozen build date such as
+		// "Gecko/20100101" instead of a version. The Gecko version has matched
+		// the Firefox version since Firefox 4, so we use that instead.
+		if !slices.Contains(ua.engineVersion[:ua.engineVersionIndex], '.') {
+			ua.engineVersion = [32]rune{}
+			ua.engineVersionIndex = 0
+			if ua.browser == internal.BrowserFirefox && versionFromRunes(ua.version[:ua.versionIndex]).Major >= 4 {
+				ua.engineVersion = ua.version
+				ua.engineVersionIndex = ua.versionIndex
+			}
+		}
+
+	case internal.Unknown:
+		// Older versions of IE do not include the Trident token.
+		if ua.browser == internal.BrowserIE {
+			ua.engine = internal.EngineTrident
+		}
+	}
+
+	// Chrome's reduced user agent freezes the Android version to "10" and
+	// replaces the model with "K", so the real version is unknown.
+	if ua.os != internal.OSAndroid || !strings.Contains(key, "Android 10; K)") {
+		for _, prefix := range osVersionPrefixes[ua.os] {
+			if _, after, ok := strings.Cut(key, prefix); ok {
+				ua.setOSVersion(after)
+				break
+			}
+		}
+	}
+
+	// HTTP client libraries are reported as their own device type regardless
+	// of the platform they run on, unless they identify as a bot. Java is also
+	// found in J2ME and Android apps, so we keep the mobile device type for it.
+	if ua.browser.IsLibrary() && ua.device != internal.DeviceBot &&
+		(ua.browser != internal.BrowserJava || ua.device != internal.DeviceMobile) {
+		ua.device = internal.DeviceLibrary
+	}
+
 	return ua
 }
 
@@ -209,7 +468,7 @@ func (trie *RuneTrie) Put(key string) {
 		// If we encounter a match, we can store it in the trie.
 		for _, result := range matchResults {
 			if keyIndex == result.EndIndex-1 {
-				newResult := resultItem{Match: result.Match, Type: result.MatchType, Precedence: result.Precedence}
+				newResult := resultItem{Match: result.Match, Type: result.MatchType, Precedence: result.Precedence, Rune: r, Product: result.Product}
 				if !slices.Contains(node.result, newResult) {
 					node.result = append(node.result, newResult)
 				}
@@ -266,6 +525,7 @@ func (ua *UserAgent) addMatch(result resultItem) bool {
 		switch result.Match {
 		case internal.BrowserChrome,
 			internal.BrowserEdge,
+			internal.BrowserEdgeLegacy,
 			internal.BrowserFirefox,
 			internal.BrowserIE,
 			internal.BrowserOpera,
@@ -274,7 +534,53 @@ func (ua *UserAgent) addMatch(result resultItem) bool {
 			internal.BrowserSamsung,
 			internal.BrowserFalkon,
 			internal.BrowserNintendo,
-			internal.BrowserYandex:
+			internal.BrowserYandex,
+			internal.BrowserSilk,
+			internal.BrowserUC,
+			internal.BrowserQQ,
+			internal.BrowserHuawei,
+			internal.BrowserMIUI,
+			internal.BrowserWhale,
+			internal.BrowserDuckDuckGo,
+			internal.BrowserCocCoc,
+			internal.BrowserSogou,
+			internal.Browser360,
+			internal.BrowserMaxthon,
+			internal.BrowserOperaGX,
+			internal.BrowserFirefoxFocus,
+			internal.BrowserWaterfox,
+			internal.BrowserPaleMoon,
+			internal.BrowserSeaMonkey,
+			internal.BrowserLibreWolf,
+			internal.BrowserIceweasel,
+			internal.BrowserEpiphany,
+			internal.BrowserKonqueror,
+			internal.BrowserMidori,
+			internal.BrowserQutebrowser,
+			internal.BrowserLynx,
+			internal.BrowserW3m,
+			internal.BrowserLinks,
+			internal.BrowserJava,
+			internal.BrowserCurl,
+			internal.BrowserWget,
+			internal.BrowserPythonURLLib,
+			internal.BrowserGoHTTP,
+			internal.BrowserOkHttp,
+			internal.BrowserNodeFetch,
+			internal.BrowserUndici,
+			internal.BrowserAxios,
+			internal.BrowserPythonRequests,
+			internal.BrowserAIOHTTP,
+			internal.BrowserHTTPX,
+			internal.BrowserApacheHTTP,
+			internal.BrowserGuzzle,
+			internal.BrowserLibwwwPerl,
+			internal.BrowserPostman,
+			internal.BrowserInsomnia,
+			internal.BrowserGRPCGo,
+			internal.BrowserGRPCJava,
+			internal.BrowserGRPCNode,
+			internal.BrowserGRPCWeb:
 			ua.browser = result.Match
 
 		case internal.BrowserOperaMini:
@@ -291,14 +597,20 @@ func (ua *UserAgent) addMatch(result resultItem) bool {
 		switch result.Match {
 		case internal.OSChromeOS,
 			internal.OSOpenBSD,
+			internal.OSFreeBSD,
+			internal.OSNetBSD,
 			internal.OSMacOS,
 			internal.OSWindows:
 			ua.os = result.Match
-			ua.device = internal.DeviceDesktop
+			if !ua.hasDeviceClass() {
+				ua.device = internal.DeviceDesktop
+			}
 
 		case internal.OSAndroid:
 			ua.os = result.Match
-			ua.device = internal.DeviceMobile
+			if !ua.hasDeviceClass() {
+				ua.device = internal.DeviceMobile
+			}
 			// An older generic white-labeled variant of Chrome/Chromium on Android.
 			if ua.browser == internal.Unknown {
 				ua.browser = internal.BrowserAndroid
@@ -307,19 +619,42 @@ func (ua *UserAgent) addMatch(result resultItem) bool {
 				ua.browserPrecedence = internal.MatchPrecedenceMap[internal.DeviceMobile]
 			}
 
-		case internal.OSIOS:
+		case internal.OSIOS,
+			internal.OSWindowsPhone,
+			internal.OSKaiOS,
+			internal.OSSailfish,
+			internal.OSHarmonyOS:
 			ua.os = result.Match
-			if ua.device != internal.DeviceTablet {
+			if ua.device != internal.DeviceTablet && !ua.hasDeviceClass() {
 				ua.device = internal.DeviceMobile
 			}
 
-		case internal.OSLinux:
+		// Tizen and webOS run on phones, watches and TVs alike, so the device
+		// is left to the other tokens such as "Mobile" or "SmartTV".
+		case internal.OSTizen, internal.OSWebOS:
+			ua.os = result.Match
+
+		case internal.OSTVOS:
+			ua.os = result.Match
+			ua.device = internal.DeviceTV
+
+		case internal.OSWatchOS:
+			ua.os = result.Match
+			ua.device = internal.DeviceWearable
+
+		case internal.OSLinux, internal.OSFuchsia:
 			ua.os = result.Match
-			if ua.device != internal.DeviceTablet && ua.device != internal.DeviceTV {
+			if ua.device != internal.DeviceTablet && ua.device != internal.DeviceTV && !ua.hasDeviceClass() {
 				ua.device = internal.DeviceDesktop
 			}
 		}
 
+		// AI crawlers often include the platform they render pages with, but
+		// they should never be reported as a regular desktop or mobile device.
+		if ua.aiCrawler {
+			ua.device = internal.DeviceBot
+		}
+
 		ua.osPrecedence = result.Precedence
 		return true
 	}
@@ -330,6 +665,12 @@ func (ua *UserAgent) addMatch(result resultItem) bool {
 		case internal.DeviceDesktop,
 			internal.DeviceTablet,
 			internal.DeviceTV,
+			internal.DeviceConsole,
+			internal.DeviceWearable,
+			internal.DeviceXR,
+			internal.DeviceCar,
+			internal.DeviceEReader,
+			internal.DeviceSmartSpeaker,
 			internal.DeviceBot:
 			ua.device = result.Match
 
@@ -337,16 +678,130 @@ func (ua *UserAgent) addMatch(result resultItem) bool {
 			if ua.device != internal.DeviceTablet {
 				ua.device = internal.DeviceMobile
 			}
+
+		case internal.TokenAICrawler:
+			ua.device = internal.DeviceBot
+			ua.aiCrawler = true
 		}
 
 		ua.typePrecedence = result.Precedence
 		return true
 	}
 
+	// Engines
+	if result.Type == internal.MatchEngine && result.Precedence > ua.enginePrecedence {
+		ua.engine = result.Match
+		ua.enginePrecedence = result.Precedence
+		return true
+	}
+
+	// In-app browsers
+	if result.Type == internal.MatchApp && result.Precedence > ua.appPrecedence {
+		ua.app = result.Match
+		ua.appPrecedence = result.Precedence
+		return true
+	}
+
+	return false
+}
+
+// aiCrawlerResult is added when an AI crawler token is matched.
+var aiCrawlerResult = resultItem{
+	Match:      internal.TokenAICrawler,
+	Type:       internal.MatchDevice,
+	Precedence: internal.MatchPrecedenceMap[internal.TokenAICrawler],
+}
+
+// matchAICrawler marks the user agent as an AI crawler if s starts with an AI
+// crawler token. These are matched directly against the user agent instead of
+// the trie, so tokens in the list are detected without a sample in the corpus.
+func (ua *UserAgent) matchAICrawler(s string) {
+	if !ua.aiCrawler && len(s) > 0 && internal.IsAICrawlerTokenStart(s[0]) && internal.MatchAICrawlerToken(s) {
+		ua.addMatch(aiCrawlerResult)
+	}
+}
+
+// hasDeviceClass returns true if a specific device class such as a console or
+// e-reader was matched, which should not be replaced by the default device of
+// the operating system.
+func (ua *UserAgent) hasDeviceClass() bool {
+	switch ua.device {
+	case internal.DeviceConsole,
+		internal.DeviceWearable,
+		internal.DeviceXR,
+		internal.DeviceCar,
+		internal.DeviceEReader,
+		internal.DeviceSmartSpeaker:
+		return true
+	}
+
 	return false
 }
 
+// setIPad marks the user agent as an iPad that requested the desktop site.
+func (ua *UserAgent) setIPad() {
+	ua.os = internal.OSIOS
+	ua.device = internal.DeviceTablet
+	ua.vendor = agents.VendorApple
+	ua.model = "iPad"
+	ua.ambiguousIPad = false
+}
+
+// osVersionPrefixes are the tokens preceding the operating system version.
+// Windows reports the NT kernel version, which is "10.0" for Windows 11 too.
+var osVersionPrefixes = map[internal.Match][]string{
+	internal.OSAndroid:      {"Android "},
+	internal.OSIOS:          {"iPhone OS ", "CPU OS "},
+	internal.OSMacOS:        {"Mac OS X "},
+	internal.OSWindows:      {"Windows NT "},
+	internal.OSWindowsPhone: {"Windows Phone OS ", "Windows Phone "},
+	internal.OSTizen:        {"Tizen "},
+	internal.OSTVOS:         {"tvOS ", "CPU OS "},
+	internal.OSKaiOS:        {"KAIOS/", "KaiOS/"},
+}
+
+// setOSVersion stores the version number at the start of s as the OS version.
+// Apple platforms separate the version with underscores, e.g. "10_15_7".
+func (ua *UserAgent) setOSVersion(s string) {
+	for _, r := range s {
+		if r == '_' {
+			r = '.'
+		}
+		if !internal.IsDigit(r) && r != '.' || ua.osVersionIndex == cap(ua.osVersion) {
+			break
+		}
+		ua.osVersion[ua.osVersionIndex] = r
+		ua.osVersionIndex++
+	}
+}
+
+// setVersion stores the version number at the start of s as the browser version.
+func (ua *UserAgent) setVersion(s string) {
+	ua.version = [32]rune{}
+	ua.versionIndex = 0
+	for _, r := range s {
+		if !internal.IsDigit(r) && r != '.' || ua.versionIndex == cap(ua.version) {
+			break
+		}
+		ua.version[ua.versionIndex] = r
+		ua.versionIndex++
+	}
+}
+
 // NewRuneTrie allocates and returns a new *RuneTrie.
 func NewRuneTrie() *RuneTrie {
 	return new(RuneTrie)
 }
+
+// wordBounds returns the start and end of the run of letters around index i of
+// the key.
+func wordBounds(key string, i int) (int, int) {
+	start, end := i, i+1
+	for start > 0 && internal.IsLetter(rune(key[start-1])) {
+		start--
+	}
+	for end < len(key) && internal.IsLetter(rune(key[end])) {
+		end++
+	}
+	return start, end
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/medama-io/go-useragent/agents"
 	"github.com/medama-io/go-useragent/internal"
 )
 
@@ -14,15 +15,57 @@ var (
 
 type Parser struct {
 	Trie *RuneTrie
+
+	// stableVersions overrides the major version of the current stable
+	// release of browsers in the release table, see WithStableVersions.
+	stableVersions map[agents.Browser]int
 }
 
 type UserAgent struct {
 	version      [32]rune
 	versionIndex int
 
+	engineVersion      [32]rune
+	engineVersionIndex int
+
+	osVersion      [32]rune
+	osVersionIndex int
+
 	browser internal.Match
 	os      internal.Match
 	device  internal.Match
+	engine  internal.Match
+	arch    internal.Match
+	app     internal.Match
+
+	// raw is the parsed user agent, kept so it can be checked again without
+	// the caller passing it around, e.g. to verify a crawler.
+	raw string
+
+	// model is a substring of the parsed user agent to avoid allocations.
+	model  string
+	vendor agents.Vendor
+
+	// webView is set when the user agent is an embedded WebView rather than
+	// a standalone browser, including all in-app browsers.
+	webView bool
+
+	// ambiguousIPad is set when the user agent could belong to either a Mac
+	// or an iPad requesting the desktop site.
+	ambiguousIPad bool
+
+	// is64Bit is set separately from the architecture, as some user agents
+	// only tell us the device is 64-bit, e.g. "Intel Mac OS X".
+	is64Bit bool
+
+	// aiCrawler is set when the user agent matched a known AI/LLM crawler
+	// token. The device is always reported as a bot in this case.
+	aiCrawler bool
+
+	// channel is set when the release channel is known from the user agent
+	// or the parser's stable versions, otherwise it is derived from the
+	// browser version.
+	channel agents.Channel
 
 	// Precedence is the order in which the user agent matched the
 	// browser, device, and OS. The lower the number, the higher the
@@ -30,6 +73,8 @@ type UserAgent struct {
 	browserPrecedence uint8
 	osPrecedence      uint8
 	typePrecedence    uint8
+	enginePrecedence  uint8
+	appPrecedence     uint8
 }
 
 // Create a new Trie and populate it with user agent data.
@@ -49,5 +94,21 @@ func NewParser() *Parser {
 
 // Parse a user agent string and return a UserAgent struct.
 func (p *Parser) Parse(ua string) UserAgent {
-	return p.Trie.Get(ua)
+	agent := p.Trie.Get(ua)
+	agent.normaliseVersion(ua)
+	p.setChannel(&agent)
+
+	return agent
+}
+
+// setChannel resolves the release channel of the user agent using the stable
+// versions of the parser. These take precedence over the release table, so the
+// channel is resolved while the parser is known.
+func (p *Parser) setChannel(agent *UserAgent) {
+	if p.stableVersions != nil && agent.channel == "" && agent.versionIndex > 0 {
+		browser := agent.Browser()
+		if stable, ok := p.stableVersions[browser]; ok && hasChannels(browser) {
+			agent.channel = agent.releaseChannel(stable)
+		}
+	}
 }
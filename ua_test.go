@@ -7,6 +7,7 @@ import (
 	ua "github.com/medama-io/go-useragent"
 
 	"github.com/medama-io/go-useragent/agents"
+	"github.com/medama-io/go-useragent/internal"
 	"github.com/medama-io/go-useragent/testdata"
 	"github.com/stretchr/testify/assert"
 )
@@ -24,10 +25,9 @@ var resultCases = []ResultCase{
 	{Browser: agents.BrowserChrome, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "59.0.3071.115"},
 	{Browser: agents.BrowserIE, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "8.0"},
 	{Browser: agents.BrowserIE, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "10.0"},
-	// Technically should be 11.0, but we need to manually map this after getting it.
-	{Browser: agents.BrowserIE, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "7.0"},
+	{Browser: agents.BrowserIE, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "11.0"},
 	{Browser: agents.BrowserIE, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "6.0"},
-	{Browser: agents.BrowserEdge, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "15.15063"},
+	{Browser: agents.BrowserEdgeLegacy, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "40.15063"},
 	// Mac (5) 7
 	{Browser: agents.BrowserSafari, OS: agents.OSMacOS, Device: agents.DeviceDesktop, Version: "10.1.2"},
 	{Browser: agents.BrowserChrome, OS: agents.OSMacOS, Device: agents.DeviceDesktop, Version: "60.0.3112.90"},
@@ -74,10 +74,88 @@ var resultCases = []ResultCase{
 	// Linux ARM Architecture (1) 39
 	{Browser: agents.BrowserChrome, OS: agents.OSLinux, Device: agents.DeviceDesktop, Version: "88.0.4324.182"},
 	// Samsung
-	{Browser: agents.BrowserSamsung, OS: agents.OSLinux, Device: agents.DeviceTV, Version: "2.1"},
+	{Browser: agents.BrowserSamsung, OS: agents.OSTizen, Device: agents.DeviceTV, Version: "2.1"},
 	{Browser: agents.BrowserSamsung, OS: agents.OSLinux, Device: agents.DeviceDesktop, Version: "26.0"},
 	// OpenBSD (1)
 	{Browser: agents.BrowserFirefox, OS: agents.OSOpenBSD, Device: agents.DeviceDesktop, Version: "57.0"},
+	// AI Crawlers (2)
+	{Browser: agents.BrowserSafari, Device: agents.DeviceBot},
+	{Browser: agents.BrowserAndroid, OS: agents.OSAndroid, Device: agents.DeviceBot},
+
+	// HTTP Libraries (6)
+	{Browser: agents.BrowserCurl, Device: agents.DeviceLibrary, Version: "8.4.0"},
+	{Browser: agents.BrowserPythonRequests, Device: agents.DeviceLibrary, Version: "2.31.0"},
+	{Browser: agents.BrowserGoHTTP, Device: agents.DeviceLibrary, Version: "1.1"},
+	{Browser: agents.BrowserOkHttp, Device: agents.DeviceLibrary, Version: "4.12.0"},
+	{Browser: agents.BrowserPostman, Device: agents.DeviceLibrary, Version: "7.36.0"},
+	{Browser: agents.BrowserJava, Device: agents.DeviceLibrary, Version: "17.0.2"},
+
+	// gRPC Clients (4)
+	{Browser: agents.BrowserGRPCGo, Device: agents.DeviceLibrary, Version: "1.60.1"},
+	{Browser: agents.BrowserGRPCJava, Device: agents.DeviceLibrary, Version: "1.58.0"},
+	{Browser: agents.BrowserGRPCNode, Device: agents.DeviceLibrary, Version: "1.9.12"},
+	{Browser: agents.BrowserGRPCWeb, Device: agents.DeviceLibrary, Version: "0.1"},
+
+	// Chromium forks and regional browsers (16)
+	{Browser: agents.BrowserUC, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "13.4.0.1306"},
+	{Browser: agents.BrowserQQ, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "13.6"},
+	{Browser: agents.BrowserHuawei, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "14.0.0.300"},
+	{Browser: agents.BrowserMIUI, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "17.9.60731"},
+	{Browser: agents.BrowserWhale, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "3.23.214.10"},
+	{Browser: agents.BrowserDuckDuckGo, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "5"},
+	{Browser: agents.BrowserDuckDuckGo, OS: agents.OSMacOS, Device: agents.DeviceDesktop, Version: "17.0"},
+	{Browser: agents.BrowserSilk, OS: agents.OSAndroid, Device: agents.DeviceTablet, Version: "119.3.1"},
+	{Browser: agents.BrowserCocCoc, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "117.0.218"},
+	{Browser: agents.BrowserSogou, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "5.39.1"},
+	{Browser: agents.Browser360, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "13.5.1010.0"},
+	{Browser: agents.BrowserMaxthon, OS: agents.OSIOS, Device: agents.DeviceMobile, Version: "6.2.6.100"},
+	{Browser: agents.BrowserOperaGX, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "2.2"},
+	{Browser: agents.BrowserEdge, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "120.0.2210.115"},
+	{Browser: agents.BrowserFirefoxFocus, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "119.0"},
+	{Browser: agents.BrowserFirefoxFocus, OS: agents.OSAndroid, Device: agents.DeviceMobile, Version: "8.8.2"},
+
+	// Other operating systems (13)
+	{Browser: agents.BrowserFirefox, OS: agents.OSFreeBSD, Device: agents.DeviceDesktop, Version: "121.0"},
+	{Browser: agents.BrowserFirefox, OS: agents.OSNetBSD, Device: agents.DeviceDesktop, Version: "120.0"},
+	{Browser: agents.BrowserEdgeLegacy, OS: agents.OSWindowsPhone, Device: agents.DeviceMobile, Version: "40.15063"},
+	{Browser: agents.BrowserIE, OS: agents.OSWindowsPhone, Device: agents.DeviceMobile, Version: "10.0"},
+	{Browser: agents.BrowserFirefox, OS: agents.OSKaiOS, Device: agents.DeviceMobile, Version: "48.0"},
+	{Browser: agents.BrowserSamsung, OS: agents.OSTizen, Device: agents.DeviceMobile, Version: "1.0"},
+	{Browser: agents.BrowserChrome, OS: agents.OSWebOS, Device: agents.DeviceTV, Version: "79.0.3945.79"},
+	{Browser: agents.BrowserHuawei, OS: agents.OSHarmonyOS, Device: agents.DeviceMobile, Version: "14.0.0.300"},
+	{Browser: agents.BrowserHuawei, OS: agents.OSHarmonyOS, Device: agents.DeviceMobile, Version: "5.0.4.300"},
+	{Browser: agents.BrowserChrome, OS: agents.OSFuchsia, Device: agents.DeviceDesktop, Version: "114.0.0.0"},
+	{Browser: agents.BrowserFirefox, OS: agents.OSSailfish, Device: agents.DeviceMobile, Version: "91.0"},
+	{Browser: agents.BrowserSafari, OS: agents.OSTVOS, Device: agents.DeviceTV},
+	{Browser: agents.BrowserSafari, OS: agents.OSWatchOS, Device: agents.DeviceWearable},
+
+	// Gecko forks and niche desktop browsers (13)
+	{Browser: agents.BrowserWaterfox, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "6.0.5"},
+	{Browser: agents.BrowserPaleMoon, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "32.5.0"},
+	{Browser: agents.BrowserPaleMoon, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "26.0.0"},
+	{Browser: agents.BrowserSeaMonkey, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "2.53.18"},
+	{Browser: agents.BrowserLibreWolf, OS: agents.OSLinux, Device: agents.DeviceDesktop, Version: "121.0"},
+	{Browser: agents.BrowserIceweasel, OS: agents.OSLinux, Device: agents.DeviceDesktop, Version: "38.2.1"},
+	{Browser: agents.BrowserEpiphany, OS: agents.OSLinux, Device: agents.DeviceDesktop, Version: "45.1"},
+	{Browser: agents.BrowserKonqueror, OS: agents.OSLinux, Device: agents.DeviceDesktop, Version: "23.08"},
+	{Browser: agents.BrowserMidori, OS: agents.OSLinux, Device: agents.DeviceDesktop, Version: "11.2"},
+	{Browser: agents.BrowserQutebrowser, OS: agents.OSLinux, Device: agents.DeviceDesktop, Version: "3.1.0"},
+	{Browser: agents.BrowserLynx, Version: "2.9.0"},
+	{Browser: agents.BrowserW3m, Version: "0.5.3"},
+	{Browser: agents.BrowserLinks, OS: agents.OSLinux, Device: agents.DeviceDesktop, Version: "2.29"},
+
+	// Edge Legacy on Xbox (1)
+	{Browser: agents.BrowserEdgeLegacy, OS: agents.OSWindows, Device: agents.DeviceConsole, Version: "44.19041"},
+
+	// Desktop Chromium forks (3)
+	{Browser: agents.Browser360, OS: agents.OSWindows, Device: agents.DeviceDesktop},
+	{Browser: agents.Browser360, OS: agents.OSWindows, Device: agents.DeviceDesktop},
+	{Browser: agents.BrowserMaxthon, OS: agents.OSWindows, Device: agents.DeviceDesktop, Version: "7.1.6.1000"},
+
+	// Watches and TVs (3)
+	{Browser: agents.BrowserSamsung, OS: agents.OSTizen, Device: agents.DeviceMobile, Version: "1.0"},
+	{Browser: agents.BrowserSafari, OS: agents.OSTVOS, Device: agents.DeviceTV},
+	{Browser: agents.BrowserSafari, OS: agents.OSTVOS, Device: agents.DeviceTV, Version: "17.0"},
 }
 
 func TestParse(t *testing.T) {
@@ -93,3 +171,269 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestAICrawler(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent string
+		Expected  bool
+	}{
+		{"Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko); compatible; GPTBot/1.2; +https://openai.com/gptbot", true},
+		{"Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; ClaudeBot/1.0; +claudebot@anthropic.com)", true},
+		{"Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; PerplexityBot/1.0; +https://perplexity.ai/perplexitybot)", true},
+		{"CCBot/2.0 (https://commoncrawl.org/faq/)", true},
+		{"meta-externalagent/1.1 (+https://developers.facebook.com/docs/sharing/webmasters/crawler)", true},
+		{"Mozilla/5.0 (compatible; FacebookBot/1.0; +https://developers.facebook.com/docs/sharing/webmasters/facebookbot/)", true},
+		{"Claude-Web/1.0 (web crawler; +https://www.anthropic.com/; bots@anthropic.com)", true},
+		{"anthropic-ai", true},
+		{"cohere-ai", true},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/123.0.0.0 Safari/537.36; compatible; Timpibot/0.8; +http://www.timpi.io", true},
+		{"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", false},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36", false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.Expected, result.IsAICrawler(), "AI Crawler\nTest Case: %s", c.UserAgent)
+			if c.Expected {
+				assert.Equal(t, agents.DeviceBot, result.Device(), "Device\nTest Case: %s", c.UserAgent)
+			}
+		})
+	}
+}
+
+func TestAICrawlerTokens(t *testing.T) {
+	parser := ua.NewParser()
+
+	// Every token in the list must be detected in the common crawler shapes,
+	// whether or not the corpus has a sample for it.
+	shapes := []string{
+		"%s/1.0",
+		"Mozilla/5.0 (compatible; %s/1.0; +https://example.com/bot)",
+		"Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; %s/1.0; +https://example.com/bot)",
+	}
+
+	for i, token := range internal.AICrawlerTokens {
+		for j, shape := range shapes {
+			t.Run(fmt.Sprintf("Case:%d:%d", i, j), func(t *testing.T) {
+				userAgent := fmt.Sprintf(shape, token)
+				result := parser.Parse(userAgent)
+				assert.True(t, result.IsAICrawler(), "AI Crawler\nTest Case: %s", userAgent)
+				assert.True(t, result.IsBot(), "Bot\nTest Case: %s", userAgent)
+			})
+		}
+	}
+}
+
+func TestEngine(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent string
+		Engine    agents.Engine
+		Version   string
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36", agents.EngineBlink, "118.0.0.0"},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12_6) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/79.0.3945.130 Safari/537.36 Edg/79.0.309.71", agents.EngineBlink, "79.0.3945.130"},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12_6) AppleWebKit/603.3.8 (KHTML, like Gecko) Version/10.1.2 Safari/603.3.8", agents.EngineWebKit, "603.3.8"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 10_3_2 like Mac OS X) AppleWebKit/603.1.30 (KHTML, like Gecko) CriOS/60.0.3112.89 Mobile/14F89 Safari/602.1", agents.EngineWebKit, "603.1.30"},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10.12; rv:54.0) Gecko/20100101 Firefox/54.0", agents.EngineGecko, "54.0"},
+		{"Mozilla/5.0 (Android 13; Mobile; rv:123.0) Gecko/123.0 Firefox/123.0", agents.EngineGecko, "123.0"},
+		{"Mozilla/5.0 (Windows NT 10.0; Trident/7.0; rv:11.0) like Gecko", agents.EngineTrident, "7.0"},
+		{"Mozilla/4.0 (compatible; MSIE 6.0; Windows NT 5.1; SV1; .NET CLR 1.1.4322) NS8/0.9.6", agents.EngineTrident, ""},
+		{"Mozilla/5.0 (Windows NT 10.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/52.0.2743.116 Safari/537.36 Edge/15.15063", agents.EngineEdgeHTML, "15.15063"},
+		{"Opera/9.80 (Windows NT 6.1; U; ru) Presto/2.10.289 Version/12.01", agents.EnginePresto, "2.10.289"},
+		{"curl/8.4.0", "", ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.Engine, result.Engine(), "Engine\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.Version, result.EngineVersion(), "Engine Version\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
+
+func TestArchitecture(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent string
+		Arch      agents.Architecture
+		Is64Bit   bool
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36", agents.ArchX86_64, true},
+		{"Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/59.0.3071.115 Safari/537.36", agents.ArchX86_64, true},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:52.0) Gecko/20100101 Firefox/52.0", agents.ArchX86_64, true},
+		{"Mozilla/5.0 (X11; Linux i686; rv:109.0) Gecko/20100101 Firefox/119.0", agents.ArchX86, false},
+		{"Mozilla/5.0 (X11; Linux aarch64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/88.0.4324.182 Safari/537.36", agents.ArchARM64, true},
+		{"Mozilla/5.0 (Linux; arm_64; Android 13; RMX3511) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.27 YaBrowser/24.1.7.27.00 (alpha) SA/3 Mobile Safari/537.36", agents.ArchARM64, true},
+		{"Mozilla/5.0 (X11; CrOS armv7l 6946.86.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/43.0.2357.134 Safari/537.36", agents.ArchARM, false},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12_6) AppleWebKit/603.3.8 (KHTML, like Gecko) Version/10.1.2 Safari/603.3.8", agents.ArchUnknown, true},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 10_3_2 like Mac OS X) AppleWebKit/603.2.4 (KHTML, like Gecko) Version/10.0 Mobile/14F89 Safari/602.1", agents.ArchUnknown, false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.Arch, result.Architecture(), "Architecture\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.Is64Bit, result.Is64Bit(), "64-bit\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
+
+func TestDeviceModel(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent string
+		Vendor    agents.Vendor
+		Model     string
+	}{
+		{"Mozilla/5.0 (Linux; Android 6.0.1; SAMSUNG SM-G930F Build/MMB29K) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/4.0 Chrome/44.0.2403.133 Mobile Safari/537.36", agents.VendorSamsung, "SM-G930F"},
+		{"Mozilla/5.0 (Linux; U; Android 4.4.2; en-us; Z520 Build/KOT49H) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30", "", "Z520"},
+		{"Mozilla/5.0 (Linux; Android 10; moto g(8) power lite) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/112.0.0.0 Mobile Safari/537.36", agents.VendorMotorola, "moto g(8) power lite"},
+		{"Mozilla/5.0 (Linux; arm_64; Android 13; RMX3511) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.27 YaBrowser/24.1.7.27.00 (alpha) SA/3 Mobile Safari/537.36", agents.VendorRealme, "RMX3511"},
+		{"Mozilla/5.0 (Linux; Android 11; SAMSUNG SM-A022G Build/RP1A.200720.012; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 SamsungBrowser/127.0.6533.64 Chrome/125.0.6422.165 Mobile Safari/537.36", agents.VendorSamsung, "SM-A022G"},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8 Pro) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36", agents.VendorGoogle, "Pixel 8 Pro"},
+		{"Mozilla/5.0 (Linux; Android 10; HarmonyOS; ELS-AN00; HMSCore 6.11.0.302) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/99.0.4844.88 HuaweiBrowser/14.0.0.300 Mobile Safari/537.36", agents.VendorHuawei, "ELS-AN00"},
+		{"Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36", "", ""},
+		{"Mozilla/5.0 (Android 13; Mobile; rv:123.0) Gecko/123.0 Firefox/123.0", "", ""},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 10_3_2 like Mac OS X) AppleWebKit/603.2.4 (KHTML, like Gecko) Version/10.0 Mobile/14F89 Safari/602.1", agents.VendorApple, "iPhone"},
+		{"Mozilla/5.0 (iPad; CPU OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/119.0.6045.169 Mobile/15E148 Safari/604.1", agents.VendorApple, "iPad"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36", "", ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.Vendor, result.DeviceVendor(), "Device Vendor\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.Model, result.DeviceModel(), "Device Model\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
+
+func TestAmbiguousIPad(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent string
+		OS        agents.OS
+		Device    agents.Device
+		Ambiguous bool
+	}{
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15", agents.OSMacOS, agents.DeviceDesktop, true},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/119.0.6045.169 Version/17.1 Safari/605.1.15", agents.OSIOS, agents.DeviceTablet, false},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36", agents.OSMacOS, agents.DeviceDesktop, false},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_14_6) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/14.1.2 Safari/605.1.15", agents.OSMacOS, agents.DeviceDesktop, false},
+		{"Mozilla/5.0 (iPad; CPU OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1", agents.OSIOS, agents.DeviceTablet, false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.OS, result.OS(), "OS\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.Device, result.Device(), "Device\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.Ambiguous, result.AmbiguousIPad(), "Ambiguous iPad\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
+
+func TestDeviceClass(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent string
+		Device    agents.Device
+	}{
+		{"Mozilla/5.0 (PlayStation; PlayStation 5/2.26) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/13.0 Safari/605.1.15", agents.DeviceConsole},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64; Xbox; Xbox Series X) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/48.0.2564.82 Safari/537.36 Edge/20.02", agents.DeviceConsole},
+		{"Mozilla/5.0 (New Nintendo 3DS like iPhone) AppleWebKit/536.30 (KHTML, like Gecko) NX/3.0.0.5.15 Mobile NintendoBrowser/1.3.10126.EU", agents.DeviceConsole},
+		{"Mozilla/5.0 (compatible; MSIE 10.0; Windows Phone 8.0; Trident/6.0; IEMobile/10.0; Xbox; Xbox One)", agents.DeviceMobile},
+		{"Mozilla/5.0 (Watch; CPU watchOS 10_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/10.0 Mobile/21S71 Safari/605.1.15", agents.DeviceWearable},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel Watch 2) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.210 Mobile Safari/537.36", agents.DeviceWearable},
+		{"Mozilla/5.0 (Linux; Android 11; SM-R910) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/112.0.5615.136 Mobile Safari/537.36", agents.DeviceWearable},
+		{"Mozilla/5.0 (Linux; Android 11; SAMSUNG SM-R910) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/1.0 Chrome/92.0.4515.166 Mobile Safari/537.36", agents.DeviceWearable},
+		{"Mozilla/5.0 (X11; Linux x86_64; Quest 3) AppleWebKit/537.36 (KHTML, like Gecko) OculusBrowser/31.4.0.6.51 Chrome/120.0.6099.282 VR Safari/537.36", agents.DeviceXR},
+		{"Mozilla/5.0 (Linux; Android 12; Quest 3) AppleWebKit/537.36 (KHTML, like Gecko) OculusBrowser/30.0.0.9.29 SamsungBrowser/4.0 Chrome/114.0.5735.320 Mobile VR Safari/537.36", agents.DeviceXR},
+		{"Mozilla/5.0 (Linux; Android 10; Quest 2) AppleWebKit/537.36 (KHTML, like Gecko) OculusBrowser/26.1.0.9.54 SamsungBrowser/4.0 Chrome/110.0.5481.192 VR Safari/537.36", agents.DeviceXR},
+		{"Mozilla/5.0 (Linux; Android 12; Quest Pro) AppleWebKit/537.36 (KHTML, like Gecko) OculusBrowser/30.0.0.9.29 SamsungBrowser/4.0 Chrome/114.0.5735.320 VR Safari/537.36", agents.DeviceXR},
+		{"Mozilla/5.0 (Linux; Android 7.0; SAMSUNG SM-G950F Build/NRD90M) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/5.0 Chrome/51.0.2704.106 Mobile VR Safari/537.36", agents.DeviceXR},
+		{"Mozilla/5.0 (Linux; Tizen 6.0; SAMSUNG SM-R890) AppleWebKit/537.36 (KHTML, like Gecko) Version/1.0 Mobile Safari/537.36", agents.DeviceMobile},
+		{"Mozilla/5.0 (X11; GNU/Linux) AppleWebKit/537.36 (KHTML, like Gecko) Chromium/79.0.3945.130 Chrome/79.0.3945.130 Safari/537.36 Tesla/2020.16.2.1-e99c70fff409", agents.DeviceCar},
+		{"Mozilla/5.0 (X11; U; Linux armv7l like Android; en-us) AppleWebKit/531.2+ (KHTML, like Gecko) Version/5.0 Safari/533.2+ Kindle/3.0+", agents.DeviceEReader},
+		{"Mozilla/5.0 (Linux; U; Android 2.0; en-us;) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 Mobile Safari/533.1 (Kobo Touch)", agents.DeviceEReader},
+		{"Mozilla/5.0 (Linux; U; Android 2.3.4; en-us; Kindle Fire Build/GINGERBREAD) AppleWebKit/533.1 (KHTML, like Gecko) Version/4.0 Mobile Safari/533.1", agents.DeviceTablet},
+		{"Mozilla/5.0 (Linux; U; Android 4.0.3; en-us; KFTT Build/IML74K) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Safari/534.30 Silk/3.68 like Chrome/39.0.2171.93", agents.DeviceTablet},
+		{"Mozilla/5.0 (Linux; Android 5.1.1; KFGIWI) AppleWebKit/537.36 (KHTML, like Gecko) Silk/89.4.1 like Chrome/89.0.4389.90 Safari/537.36", agents.DeviceTablet},
+		{"Mozilla/5.0 (X11; Linux aarch64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/117.0.5938.132 Safari/537.36 CrKey/1.56.500000 DeviceType/SmartDisplay", agents.DeviceSmartSpeaker},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.Device, result.Device(), "Device\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
+
+func TestInAppBrowser(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent string
+		App       agents.App
+		WebView   bool
+	}{
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [FBAN/FBIOS;FBAV/442.0.0.38.109;FBBV/556466013;FBDV/iPhone14,2;FBMD/iPhone;FBSN/iOS;FBSV/17.1.2;FBSS/3;FBID/phone;FBLC/en_US;FBOP/5;FBRV/557654321]", agents.AppFacebook, true},
+		{"Mozilla/5.0 (Linux; Android 13; SM-S911B Build/TP1A.220624.014; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/119.0.6045.163 Mobile Safari/537.36 [FB_IAB/FB4A;FBAV/442.0.0.33.110;]", agents.AppFacebook, true},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Instagram 305.0.0.14.106 (iPhone15,2; iOS 17_0; en_US; en; scale=3.00; 1179x2556; 532237233)", agents.AppInstagram, true},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8 Build/UD1A.231105.004; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/119.0.6045.193 Mobile Safari/537.36 Instagram 309.1.0.41.113 Android (34/14; 420dpi; 1080x2400; Google/google; Pixel 8; shiba; shiba; en_US; 541635890)", agents.AppInstagram, true},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 musical_ly_32.5.0 JsSdk/2.0 NetType/WIFI Channel/App Store ByteLocale/en Region/US RevealType/Dialog isDarkMode/0 WKWebView/1 BytedanceWebview/d8a21c6", agents.AppTikTok, true},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [LinkedInApp]/9.29.6660", agents.AppLinkedIn, true},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Snapchat/12.62.0.37 (like Safari/8617.2.4.10.8, panda)", agents.AppSnapchat, true},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 MicroMessenger/8.0.42(0x18002a2c) NetType/WIFI Language/zh_CN", agents.AppWeChat, true},
+		{"Mozilla/5.0 (Linux; Android 12; SM-G973F Build/SP1A.210812.016; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/120.0.6099.144 Mobile Safari/537.36 Snapchat/12.60.0.44 (SM-G973F; Android 12#5f6b4a7b4b#31; gzip; )", agents.AppSnapchat, true},
+		{"Mozilla/5.0 (Linux; Android 13; SM-A536B Build/TP1A.220624.014; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/119.0.6045.163 Mobile Safari/537.36 trill_2023209030 JsSdk/1.0 NetType/WIFI Channel/googleplay AppName/musical_ly app_version/32.9.3 ByteLocale/en ByteFullLocale/en Region/US Spark/1.4.6.3-bugfix AppVersion/32.9.3 BytedanceWebview/d8a21c6", agents.AppTikTok, true},
+		{"Mozilla/5.0 (Linux; Android 13; Pixel 7 Build/TQ3A.230901.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/118.0.5993.111 Mobile Safari/537.36 [LinkedInApp]/9.1.210", agents.AppLinkedIn, true},
+		{"Mozilla/5.0 (Linux; Android 13; V2227A Build/TP1A.220624.014; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/111.0.5563.116 Mobile Safari/537.36 XWEB/1110017 MMWEBSDK/20230805 MMWEBID/7427 MicroMessenger/8.0.42.2460(0x28002A3B) WeChat/arm64 Weixin NetType/WIFI Language/zh_CN ABI/arm64", agents.AppWeChat, true},
+		{"Mozilla/5.0 (Linux; Android 13; SC-51C Build/TP1A.220624.014; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/119.0.6045.163 Mobile Safari/537.36 Line/13.21.0/IAB", agents.AppLine, true},
+		{"Mozilla/5.0 (Linux; Android 12; SM-G991B Build/SP1A.210812.016; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/108.0.5359.128 Mobile Safari/537.36 Line/13.20.1", agents.AppLine, true},
+		{"Mozilla/5.0 (Linux; Android 13; Pixel 7 Build/TQ3A.230901.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/116.0.5845.163 Mobile Safari/537.36 Line/13.20.1/IAB", agents.AppLine, true},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Safari Line/13.21.0", agents.AppLine, true},
+		{"Mozilla/5.0 (Linux; Android 12; Pixel 6 Build/SD1A.210817.023; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/94.0.4606.71 Mobile Safari/537.36", "", true},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148", "", true},
+		{"Snapchat/12.46.0.67 (iPhone15,2; iOS 17.0; gzip) grpc-c++/1.48.0 grpc-c/26.0.0 (ios; cronet_http)", "", false},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 10_3_2 like Mac OS X) AppleWebKit/603.2.4 (KHTML, like Gecko) Version/10.0 Mobile/14F89 Safari/602.1", "", false},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8 Pro) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36", "", false},
+		{"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)", "", false},
+		{"Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; Linespider/1.1; +https://lin.ee/4dwXkTH) Chrome/101.0.4951.0 Safari/537.36", "", false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.App, result.InAppBrowser(), "In-App Browser\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.WebView, result.IsWebView(), "WebView\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
+
+func TestLongerBrowserToken(t *testing.T) {
+	// The trie only has a path to "Edg", so "Edge" is found from the complete
+	// word rather than the corpus.
+	trie := ua.NewRuneTrie()
+	trie.Put("MozillaWindowsNTWinxXboxXboxOneAppleWebKitKHTMLlikeGeckoChromeSafariEdg")
+
+	result := trie.Get("Mozilla/5.0 (Windows NT 10.0; Win64; x64; Xbox; Xbox One) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edge/18.19041")
+	assert.Equal(t, agents.BrowserEdgeLegacy, result.Browser())
+	assert.Equal(t, "18.19041", result.BrowserVersion())
+	assert.Equal(t, agents.EngineEdgeHTML, result.Engine())
+
+	result = trie.Get("Mozilla/5.0 (Windows NT 10.0; Win64; x64; Xbox; Xbox One) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36 Edg/118.0.2088.46")
+	assert.Equal(t, agents.BrowserEdge, result.Browser())
+	assert.Equal(t, "118.0.2088.46", result.BrowserVersion())
+}
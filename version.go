@@ -0,0 +1,179 @@
+package useragent
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/medama-io/go-useragent/internal"
+)
+
+// Version is a parsed version number, e.g. "124.0.6367.91". Missing components
+// are zero. The zero value is an unknown version.
+//
+// Versions only hold their components, so they can be compared with == as well
+// as Compare, e.g. ParseVersion("118.0.0.0") == Version{Major: 118}.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	Build int
+	// Suffix is the pre-release suffix directly following the version, e.g.
+	// "a1" in "125.0a1". User agent versions are parsed without it, so use
+	// BrowserChannel to detect pre-release builds.
+	Suffix string
+}
+
+// ParseVersion parses a version string such as "17.4.1" or "125.0a1". Parsing
+// stops at the first character that is not part of the version or its suffix.
+func ParseVersion(s string) Version {
+	var v Version
+	var parts, i int
+	for {
+		start := i
+		n := 0
+		for i < len(s) && internal.IsDigit(rune(s[i])) {
+			n = n*10 + int(s[i]-'0')
+			i++
+		}
+		if i == start {
+			break
+		}
+		v.set(parts, n)
+		parts++
+
+		if parts == 4 || i+1 >= len(s) || s[i] != '.' || !internal.IsDigit(rune(s[i+1])) {
+			break
+		}
+		i++
+	}
+
+	// The suffix must start with a letter, e.g. "b9" but not ".5".
+	if parts > 0 && i < len(s) && internal.IsLetter(rune(s[i])) {
+		start := i
+		for i < len(s) && (internal.IsLetter(rune(s[i])) || internal.IsDigit(rune(s[i]))) {
+			i++
+		}
+		v.Suffix = s[start:i]
+	}
+
+	return v
+}
+
+// versionFromRunes parses a version buffer without allocating.
+func versionFromRunes(version []rune) Version {
+	var v Version
+	var parts int
+	n, digits := 0, false
+	for _, r := range version {
+		if r == '.' {
+			if !digits || parts == 3 {
+				break
+			}
+			v.set(parts, n)
+			parts++
+			n, digits = 0, false
+			continue
+		}
+		if !internal.IsDigit(r) {
+			break
+		}
+		n = n*10 + int(r-'0')
+		digits = true
+	}
+	if digits {
+		v.set(parts, n)
+	}
+
+	return v
+}
+
+// set stores n as the version component at index i.
+func (v *Version) set(i, n int) {
+	switch i {
+	case 0:
+		v.Major = n
+	case 1:
+		v.Minor = n
+	case 2:
+		v.Patch = n
+	case 3:
+		v.Build = n
+	}
+}
+
+// IsZero returns true if the version is unknown.
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// Compare returns -1 if v is older than other, 1 if it is newer and 0 if they
+// are equal. Missing components are treated as zero. A pre-release suffix is
+// older than the same version without one, e.g. "125.0a1" is older than
+// "125.0", and suffixes are otherwise compared alphabetically.
+func (v Version) Compare(other Version) int {
+	for _, c := range [...][2]int{
+		{v.Major, other.Major},
+		{v.Minor, other.Minor},
+		{v.Patch, other.Patch},
+		{v.Build, other.Build},
+	} {
+		switch {
+		case c[0] < c[1]:
+			return -1
+		case c[0] > c[1]:
+			return 1
+		}
+	}
+
+	switch {
+	case v.Suffix == other.Suffix:
+		return 0
+	case v.Suffix == "":
+		return 1
+	case other.Suffix == "":
+		return -1
+	}
+
+	return strings.Compare(v.Suffix, other.Suffix)
+}
+
+// LessThan returns true if v is older than other.
+func (v Version) LessThan(other Version) bool {
+	return v.Compare(other) < 0
+}
+
+// AtLeast returns true if v is the same as or newer than the version string,
+// e.g. "115" or "17.4".
+func (v Version) AtLeast(s string) bool {
+	return v.Compare(ParseVersion(s)) >= 0
+}
+
+// String returns the version in dotted form with its suffix, e.g. "17.4.1" or
+// "125.0a1". Trailing zero components after the minor version are omitted, so
+// "118.0.0.0" is formatted as "118.0". Use UserAgent.BrowserVersion for the
+// version as it was written in the user agent.
+func (v Version) String() string {
+	if v.IsZero() {
+		return ""
+	}
+
+	components := [...]int{v.Major, v.Minor, v.Patch, v.Build}
+	parts := 2
+	switch {
+	case v.Build != 0:
+		parts = 4
+	case v.Patch != 0:
+		parts = 3
+	}
+
+	var b strings.Builder
+	for i, n := range components[:parts] {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(strconv.Itoa(n))
+	}
+	b.WriteString(v.Suffix)
+
+	return b.String()
+}
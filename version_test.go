@@ -0,0 +1,114 @@
+package useragent_test
+
+import (
+	"fmt"
+	"testing"
+
+	ua "github.com/medama-io/go-useragent"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	cases := []struct {
+		Version string
+		Result  ua.Version
+		String  string
+	}{
+		{"", ua.Version{}, ""},
+		{"115", ua.Version{Major: 115}, "115.0"},
+		{"17.4.1", ua.Version{Major: 17, Minor: 4, Patch: 1}, "17.4.1"},
+		{"124.0.6367.91", ua.Version{Major: 124, Patch: 6367, Build: 91}, "124.0.6367.91"},
+		{"118.0.0.0", ua.Version{Major: 118}, "118.0"},
+		{"125.0a1", ua.Version{Major: 125, Suffix: "a1"}, "125.0a1"},
+		{"124.0b9 Mobile", ua.Version{Major: 124, Suffix: "b9"}, "124.0b9"},
+		{"1.2.3.4.5", ua.Version{Major: 1, Minor: 2, Patch: 3, Build: 4}, "1.2.3.4"},
+		{"2.29;", ua.Version{Major: 2, Minor: 29}, "2.29"},
+		{"beta", ua.Version{}, ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			v := ua.ParseVersion(c.Version)
+			assert.Equal(t, c.Result, v, "Version\nTest Case: %s", c.Version)
+			assert.Equal(t, 0, v.Compare(c.Result), "Compare\nTest Case: %s", c.Version)
+			assert.Equal(t, c.String, v.String(), "String\nTest Case: %s", c.Version)
+		})
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	cases := []struct {
+		A, B   string
+		Result int
+	}{
+		{"115", "115.0.0", 0},
+		{"115.0.1", "115", 1},
+		{"9.1", "10", -1},
+		{"124.0.6367.91", "124.0.6367.201", -1},
+		{"125.0a1", "125.0", -1},
+		{"125.0a1", "125.0b1", -1},
+		{"125.0a1", "124.0", 1},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			a, b := ua.ParseVersion(c.A), ua.ParseVersion(c.B)
+			assert.Equal(t, c.Result, a.Compare(b), "Compare\nTest Case: %s %s", c.A, c.B)
+			assert.Equal(t, -c.Result, b.Compare(a), "Reverse Compare\nTest Case: %s %s", c.A, c.B)
+			assert.Equal(t, c.Result < 0, a.LessThan(b), "LessThan\nTest Case: %s %s", c.A, c.B)
+			assert.Equal(t, c.Result >= 0, a.AtLeast(c.B), "AtLeast\nTest Case: %s %s", c.A, c.B)
+		})
+	}
+}
+
+func TestVersionString(t *testing.T) {
+	assert.Equal(t, "17.0", ua.Version{Major: 17}.String())
+	assert.Equal(t, "17.4", ua.Version{Major: 17, Minor: 4}.String())
+	assert.Equal(t, "1.0.0.5", ua.Version{Major: 1, Build: 5}.String())
+	assert.Equal(t, "125.0a1", ua.Version{Major: 125, Suffix: "a1"}.String())
+	assert.Equal(t, "", ua.Version{}.String())
+
+	// Versions are equal with == regardless of how they were written.
+	assert.True(t, ua.ParseVersion("1.0") == ua.Version{Major: 1})
+	assert.True(t, ua.ParseVersion("1.0.0.0") == ua.ParseVersion("1"))
+}
+
+func TestUserAgentVersion(t *testing.T) {
+	parser := ua.NewParser()
+
+	cases := []struct {
+		UserAgent string
+		Version   string
+		OSVersion string
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.5993.88 Safari/537.36", "118.0.5993.88", "10.0"},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15", "17.1", "10.15.7"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1.2 Mobile/15E148 Safari/604.1", "17.1.2", "17.1.2"},
+		{"Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/119.0.6045.109 Mobile/15E148 Safari/604.1", "119.0.6045.109", "16.6"},
+		{"Mozilla/5.0 (Linux; Android 14; SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.163 Mobile Safari/537.36", "119.0.6045.163", "14.0"},
+		{"Mozilla/5.0 (Windows Phone 10.0; Android 6.0.1; Microsoft; Lumia 950) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/52.0.2743.116 Mobile Safari/537.36 Edge/15.15063", "40.15063", "10.0"},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:109.0) Gecko/20100101 Firefox/119.0", "119.0", ""},
+		{"Mozilla/5.0 (Linux; Tizen 5.5; SAMSUNG SM-R820) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/1.0 Chrome/56.0.2924.0 Mobile Safari/537.36", "1.0", "5.5"},
+		{"Mozilla/5.0 (Apple TV; CPU OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15", "17.0", "17.0"},
+		{"Googlebot/2.1 (+http://www.google.com/bot.html)", "", ""},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Case:%d", i), func(t *testing.T) {
+			result := parser.Parse(c.UserAgent)
+			assert.Equal(t, c.Version, result.Version().String(), "Version\nTest Case: %s", c.UserAgent)
+			assert.Equal(t, c.OSVersion, result.OSVersion().String(), "OS Version\nTest Case: %s", c.UserAgent)
+		})
+	}
+}
+
+func TestUserAgentVersionAllocs(t *testing.T) {
+	result := ua.NewParser().Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.5993.88 Safari/537.36")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = result.Version().AtLeast("115")
+		_ = result.OSVersion().Major
+	})
+	assert.Zero(t, allocs)
+}